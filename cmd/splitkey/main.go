@@ -0,0 +1,88 @@
+// Command splitkey splits a validator key between an operator committee with a trusted dealer (see dkg.SplitValidatorKey),
+// writing the operators' shares, encrypted share secrets and the committee as json.
+//
+//	go run ./cmd/splitkey -operators operators.json [-validator-sk <hex>] [-threshold <t>] [-domain <hex>] [-fee-recipient <address>] [-out shares.json]
+//
+// The operators file is a json list of types.Operator with OperatorID and SSVOperatorPubKey (base64 PKIX RSA public key).
+// A new validator key is generated if -validator-sk is empty, the threshold defaults to the committee's quorum.
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/dkg"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+func main() {
+	operatorsFile := flag.String("operators", "", "json file of the committee's operators")
+	validatorSK := flag.String("validator-sk", "", "hex encoded validator secret key, generated if empty")
+	threshold := flag.Uint64("threshold", 0, "number of shares reconstructing a signature, the committee's quorum if 0")
+	domain := flag.String("domain", hex.EncodeToString(types.GenesisMainnet[:]), "hex encoded ssv domain type")
+	feeRecipient := flag.String("fee-recipient", "", "hex encoded fee recipient address")
+	out := flag.String("out", "", "output file, stdout if empty")
+	flag.Parse()
+
+	if err := run(*operatorsFile, *validatorSK, *threshold, *domain, *feeRecipient, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "could not split key: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(operatorsFile, validatorSKHex string, threshold uint64, domainHex, feeRecipient, out string) error {
+	byts, err := os.ReadFile(operatorsFile)
+	if err != nil {
+		return errors.Wrap(err, "could not read operators")
+	}
+	operators := make([]*types.Operator, 0)
+	if err := json.Unmarshal(byts, &operators); err != nil {
+		return errors.Wrap(err, "could not decode operators")
+	}
+
+	types.InitBLS()
+	var sk *bls.SecretKey
+	if len(validatorSKHex) != 0 {
+		sk = &bls.SecretKey{}
+		if err := sk.SetHexString(strings.TrimPrefix(validatorSKHex, "0x")); err != nil {
+			return errors.Wrap(err, "invalid validator secret key")
+		}
+	}
+
+	if threshold == 0 {
+		threshold, _ = types.ComputeQuorum(uint64(len(operators)))
+	}
+
+	domainByts, err := hex.DecodeString(strings.TrimPrefix(domainHex, "0x"))
+	if err != nil || len(domainByts) != len(types.DomainType{}) {
+		return errors.New("invalid domain type")
+	}
+	domain := types.DomainType{}
+	copy(domain[:], domainByts)
+
+	if len(feeRecipient) != 0 && !common.IsHexAddress(feeRecipient) {
+		return errors.New("invalid fee recipient")
+	}
+
+	result, err := dkg.SplitValidatorKey(sk, operators, threshold, domain, common.HexToAddress(feeRecipient))
+	if err != nil {
+		return err
+	}
+	byts, err = json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode result")
+	}
+
+	if len(out) == 0 {
+		_, err = fmt.Println(string(byts))
+		return err
+	}
+	return os.WriteFile(out, byts, 0600)
+}
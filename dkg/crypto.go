@@ -0,0 +1,187 @@
+package dkg
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// blsID returns the operator's evaluation point, the same as for types.ReconstructSignatures
+func blsID(operatorID types.OperatorID) (*bls.ID, error) {
+	ret := &bls.ID{}
+	if err := ret.SetDecString(fmt.Sprintf("%d", operatorID)); err != nil {
+		return nil, errors.Wrap(err, "could not set operator id")
+	}
+	return ret, nil
+}
+
+// newPolynomial returns the coefficients of a random polynomial of degree threshold-1
+func newPolynomial(threshold uint64) []bls.SecretKey {
+	secret := &bls.SecretKey{}
+	secret.SetByCSPRNG()
+	return secret.GetMasterSecretKey(int(threshold))
+}
+
+// evaluatePolynomial returns the polynomial's evaluation for the operator
+func evaluatePolynomial(coefficients []bls.SecretKey, operatorID types.OperatorID) (*bls.SecretKey, error) {
+	id, err := blsID(operatorID)
+	if err != nil {
+		return nil, err
+	}
+	ret := &bls.SecretKey{}
+	if err := ret.Set(coefficients, id); err != nil {
+		return nil, errors.Wrap(err, "could not evaluate polynomial")
+	}
+	return ret, nil
+}
+
+// evaluateCommitments returns the public key of the polynomial's evaluation for the operator from the coefficients' commitments
+func evaluateCommitments(commitments [][]byte, operatorID types.OperatorID) (*bls.PublicKey, error) {
+	pks := make([]bls.PublicKey, len(commitments))
+	for i, commitment := range commitments {
+		if err := pks[i].Deserialize(commitment); err != nil {
+			return nil, errors.Wrap(err, "could not deserialize commitment")
+		}
+	}
+	id, err := blsID(operatorID)
+	if err != nil {
+		return nil, err
+	}
+	ret := &bls.PublicKey{}
+	if err := ret.Set(pks, id); err != nil {
+		return nil, errors.Wrap(err, "could not evaluate commitments")
+	}
+	return ret, nil
+}
+
+// dealerIdentity returns the message signed by the dealer's proof of possession, binding it to the ceremony and dealer
+func dealerIdentity(requestID RequestID, operatorID types.OperatorID) []byte {
+	data := make([]byte, 0, len(requestID)+8)
+	data = append(data, requestID[:]...)
+	data = binary.LittleEndian.AppendUint64(data, operatorID)
+	ret := sha256.Sum256(data)
+	return ret[:]
+}
+
+// RequestSigningRoot returns the root signed by a reshare's or refresh's proofs of possession and partial signatures, binding them to the ceremony.
+// Same as types.ComputeSigningRoot over the request's root with the DKG signature domain
+func RequestSigningRoot(requestID RequestID, init *Init, domain types.DomainType) ([]byte, error) {
+	initRoot, err := init.GetRoot()
+	if err != nil {
+		return nil, err
+	}
+	root := sha256.Sum256(append(requestID[:], initRoot[:]...))
+	ret := sha256.Sum256(append(root[:], types.ComputeSignatureDomain(domain, types.DKGSignatureType)...))
+	return ret[:], nil
+}
+
+// zeroPublicKey returns the commitment to a zero secret (the point at infinity)
+func zeroPublicKey() []byte {
+	return (&bls.SecretKey{}).GetPublicKey().Serialize()
+}
+
+// verifyProofOfPossession returns error if the proof of possession over the message isn't signed by the public key's secret
+func verifyProofOfPossession(deal *Deal, pkByts []byte, msg []byte) error {
+	pk := &bls.PublicKey{}
+	if err := pk.Deserialize(pkByts); err != nil {
+		return errors.Wrap(err, "could not deserialize public key")
+	}
+	sig := &bls.Sign{}
+	if err := sig.Deserialize(deal.ProofOfPossession); err != nil {
+		return errors.Wrap(err, "could not deserialize proof of possession")
+	}
+	if !sig.VerifyByte(pk, msg) {
+		return errors.New("invalid proof of possession")
+	}
+	return nil
+}
+
+// interpolateSecrets returns the lagrange interpolation at 0 of the dealers' secrets
+func interpolateSecrets(secrets map[types.OperatorID]*bls.SecretKey, dealers []types.OperatorID) (*bls.SecretKey, error) {
+	vec := make([]bls.SecretKey, len(dealers))
+	ids := make([]bls.ID, len(dealers))
+	for i, dealer := range dealers {
+		vec[i] = *secrets[dealer]
+		id, err := blsID(dealer)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = *id
+	}
+	ret := &bls.SecretKey{}
+	if err := ret.Recover(vec, ids); err != nil {
+		return nil, errors.Wrap(err, "could not interpolate secrets")
+	}
+	return ret, nil
+}
+
+// interpolatePublicKeys returns the lagrange interpolation at 0 of the dealers' public keys
+func interpolatePublicKeys(pks map[types.OperatorID]*bls.PublicKey, dealers []types.OperatorID) (*bls.PublicKey, error) {
+	vec := make([]bls.PublicKey, len(dealers))
+	ids := make([]bls.ID, len(dealers))
+	for i, dealer := range dealers {
+		vec[i] = *pks[dealer]
+		id, err := blsID(dealer)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = *id
+	}
+	ret := &bls.PublicKey{}
+	if err := ret.Recover(vec, ids); err != nil {
+		return nil, errors.Wrap(err, "could not interpolate public keys")
+	}
+	return ret, nil
+}
+
+// encryptShare encrypts the share's secret to the operator's PKIX encoded RSA public key, bound to the operator ID and validator public key
+func encryptShare(encryptionPubKey []byte, operatorID types.OperatorID, validatorPK []byte, share *bls.SecretKey) ([]byte, error) {
+	parsed, err := x509.ParsePKIXPublicKey(encryptionPubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse encryption key")
+	}
+	pk, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("encryption key not rsa")
+	}
+	return types.EncryptShare(pk, operatorID, validatorPK, share.Serialize())
+}
+
+// decryptShare decrypts the share's secret with the operator's RSA private key, only envelopes are accepted (types.DecryptShare)
+func decryptShare(sk *rsa.PrivateKey, operatorID types.OperatorID, validatorPK []byte, encryptedShare []byte) (*bls.SecretKey, error) {
+	byts, err := types.DecryptShare(sk, operatorID, validatorPK, encryptedShare)
+	if err != nil {
+		return nil, err
+	}
+	ret := &bls.SecretKey{}
+	if err := ret.Deserialize(byts); err != nil {
+		return nil, errors.Wrap(err, "could not deserialize share")
+	}
+	return ret, nil
+}
+
+// verifyOutputSignature returns error if the output isn't signed by the eth address, see DKGSigner.SignDKGOutput
+func verifyOutputSignature(signedOutput *SignedOutput, address common.Address) error {
+	root, err := signedOutput.Output.GetRoot()
+	if err != nil {
+		return err
+	}
+	pk, err := ethcrypto.SigToPub(root[:], signedOutput.Signature)
+	if err != nil {
+		return errors.Wrap(err, "could not recover output signer")
+	}
+	recovered := ethcrypto.PubkeyToAddress(*pk)
+	if !bytes.Equal(recovered[:], address[:]) {
+		return errors.New("output signer doesn't match operator address")
+	}
+	return nil
+}
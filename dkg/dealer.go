@@ -0,0 +1,122 @@
+package dkg
+
+import (
+	"crypto/x509"
+	"sort"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// DealtShare is an operator's share of a validator key split by a trusted dealer
+type DealtShare struct {
+	Share *types.Share
+	// EncryptedShare is the share's secret encrypted to the operator's RSA public key (SSVOperatorPubKey)
+	EncryptedShare []byte
+}
+
+// SplitResult is a validator key split by a trusted dealer between a committee
+type SplitResult struct {
+	ValidatorPubKey []byte
+	// Committee is the operators with their share public keys, the same as every share's committee
+	Committee []*types.Operator
+	Shares    map[types.OperatorID]*DealtShare
+}
+
+// SplitValidatorKey splits the validator's secret key between the operators with a trusted dealer (Shamir secret sharing),
+// a new validator key is generated if nil. The operators' shares are evaluated at their operator IDs, as reconstructed by
+// types.ReconstructSignatures, any threshold of them reconstructs the validator's signature.
+// The committee is sorted by operator ID (as Share.Validate requires) whatever the operators' order, every share is validated.
+// Unlike a DKG ceremony, the dealer knows the validator's secret key; it's meant for tests and local devnets.
+func SplitValidatorKey(
+	validatorSK *bls.SecretKey,
+	operators []*types.Operator,
+	threshold uint64,
+	domain types.DomainType,
+	feeRecipient [20]byte,
+) (*SplitResult, error) {
+	types.InitBLS()
+
+	if err := validateSplit(operators, threshold); err != nil {
+		return nil, errors.Wrap(err, "invalid split")
+	}
+	if validatorSK == nil {
+		validatorSK = &bls.SecretKey{}
+		validatorSK.SetByCSPRNG()
+	}
+	operators = sortedOperators(operators)
+
+	coefficients := validatorSK.GetMasterSecretKey(int(threshold))
+	secrets := make(map[types.OperatorID]*bls.SecretKey)
+	ret := &SplitResult{
+		ValidatorPubKey: validatorSK.GetPublicKey().Serialize(),
+		Committee:       make([]*types.Operator, 0, len(operators)),
+		Shares:          make(map[types.OperatorID]*DealtShare),
+	}
+	for _, operator := range operators {
+		secret, err := evaluatePolynomial(coefficients, operator.OperatorID)
+		if err != nil {
+			return nil, err
+		}
+		secrets[operator.OperatorID] = secret
+		ret.Committee = append(ret.Committee, &types.Operator{
+			OperatorID:        operator.OperatorID,
+			SharePubKey:       secret.GetPublicKey().Serialize(),
+			SSVOperatorPubKey: operator.SSVOperatorPubKey,
+			Weight:            operator.Weight,
+		})
+	}
+
+	for _, operator := range ret.Committee {
+		encrypted, err := encryptShare(operator.SSVOperatorPubKey, operator.OperatorID, ret.ValidatorPubKey, secrets[operator.OperatorID])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not encrypt operator %d share", operator.OperatorID)
+		}
+		share := &types.Share{
+			OperatorID:          operator.OperatorID,
+			ValidatorPubKey:     ret.ValidatorPubKey,
+			SharePubKey:         operator.SharePubKey,
+			Committee:           ret.Committee,
+			DomainType:          domain,
+			FeeRecipientAddress: feeRecipient,
+		}
+		share.Quorum, share.PartialQuorum = types.ComputeQuorum(share.TotalWeight())
+		if threshold > share.MinQuorumSize() {
+			return nil, errors.New("invalid split: threshold above the committee's minimal quorum")
+		}
+		if err := share.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid operator %d share", operator.OperatorID)
+		}
+		ret.Shares[operator.OperatorID] = &DealtShare{
+			Share:          share,
+			EncryptedShare: encrypted,
+		}
+	}
+	return ret, nil
+}
+
+// sortedOperators returns a copy of the operators sorted by operator ID
+func sortedOperators(operators []*types.Operator) []*types.Operator {
+	ret := make([]*types.Operator, len(operators))
+	copy(ret, operators)
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].OperatorID < ret[j].OperatorID
+	})
+	return ret
+}
+
+// validateSplit returns error if the operators can't share a validator key with the threshold, the same rules as a DKG ceremony
+func validateSplit(operators []*types.Operator, threshold uint64) error {
+	operatorIDs := make([]types.OperatorID, 0, len(operators))
+	for _, operator := range operators {
+		operatorIDs = append(operatorIDs, operator.OperatorID)
+		if operator.SSVOperatorKeyType != types.RSAOperatorKey {
+			return errors.New("operator public key not rsa")
+		}
+		if _, err := x509.ParsePKIXPublicKey(operator.SSVOperatorPubKey); err != nil {
+			return errors.Wrap(err, "invalid operator public key")
+		}
+	}
+	return validateCommittee(operatorIDs, threshold)
+}
@@ -0,0 +1,203 @@
+package dkg
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+type MsgType uint64
+
+const (
+	// DealMsgType is the first round message, the dealer's polynomial commitments and encrypted shares
+	DealMsgType MsgType = iota
+	// OutputMsgType is the second round message, the operator's signed output with a partial signature over the ceremony's signing root
+	OutputMsgType
+	// AbortMsgType aborts the ceremony, blaming a misbehaving operator
+	AbortMsgType
+)
+
+// Message is a DKG message, sent as the data of an SSVMessage of type types.DKGMsgType
+type Message struct {
+	MsgType   MsgType
+	RequestID RequestID
+	Data      []byte
+}
+
+// Validate returns error if msg validation doesn't pass.
+// Msg validation checks the msg, it's variables for validity as well as timings.
+func (msg *Message) Validate() error {
+	if msg.MsgType > AbortMsgType {
+		return errors.New("unknown dkg message type")
+	}
+	if len(msg.Data) == 0 {
+		return errors.New("dkg message data empty")
+	}
+	return nil
+}
+
+// Encode returns a msg encoded bytes or error
+func (msg *Message) Encode() ([]byte, error) {
+	return json.Marshal(msg)
+}
+
+// Decode returns error if decoding failed
+func (msg *Message) Decode(data []byte) error {
+	return json.Unmarshal(data, msg)
+}
+
+// Deal is the dealer's random polynomial of degree threshold-1, committed to and evaluated for every operator (Feldman VSS).
+// The validator's secret key is the sum of all the dealers' secrets (free coefficients), never known to any operator.
+// For a reshare the dealer's secret is its old share, the validator's secret is their lagrange interpolation.
+// For a refresh the dealer's secret is zero, the operators' old shares are shifted by the evaluations.
+type Deal struct {
+	// Commitments are the commitments (BLS public keys) to the polynomial's coefficients, threshold of them
+	Commitments [][]byte
+	// ProofOfPossession is a signature by the polynomial's secret over the dealer's identity (see dealerIdentity),
+	// proving the dealer knows the secret of its first commitment and can't cancel out other dealers' secrets.
+	// For a reshare or refresh it's signed by the old share over the request's signing root, authorizing the request (see Result.ReshareAuthorization)
+	ProofOfPossession []byte
+	// EncryptedShares are the polynomial's evaluations for the operators, encrypted to the operators' encryption keys (types.EncryptShare)
+	// bound to the operator ID and the existing validator's public key (none for a new validator)
+	EncryptedShares map[types.OperatorID][]byte
+}
+
+// Validate returns error if the deal is invalid for the threshold and operators
+func (deal *Deal) Validate(init *Init) error {
+	if uint64(len(deal.Commitments)) != init.Threshold {
+		return errors.New("invalid number of commitments")
+	}
+	if len(deal.ProofOfPossession) != 96 {
+		return errors.New("invalid proof of possession")
+	}
+	if len(deal.EncryptedShares) != len(init.Operators) {
+		return errors.New("invalid number of shares")
+	}
+	for _, operator := range init.Operators {
+		if len(deal.EncryptedShares[operator.OperatorID]) == 0 {
+			return errors.New("missing share")
+		}
+	}
+	return nil
+}
+
+// GetRoot returns the deal's root, echoed in the operators' outputs
+func (deal *Deal) GetRoot() ([32]byte, error) {
+	byts, err := deal.Encode()
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not encode deal")
+	}
+	return sha256.Sum256(byts), nil
+}
+
+// Encode returns the encoded struct in bytes or error
+func (deal *Deal) Encode() ([]byte, error) {
+	return json.Marshal(deal)
+}
+
+// Decode returns error if decoding failed
+func (deal *Deal) Decode(data []byte) error {
+	return json.Unmarshal(data, deal)
+}
+
+// Output is an operator's DKG result, signed by the operator's eth address
+type Output struct {
+	RequestID       RequestID
+	OperatorID      types.OperatorID
+	ValidatorPubKey []byte
+	SharePubKey     []byte
+	// EncryptedShare is the operator's share secret encrypted to its own encryption key, registered with the validator
+	EncryptedShare []byte
+	// PartialSignature is the share's signature over the ceremony's signing root (the deposit data for a new validator),
+	// reconstructed into the validator's signature with the committee's shares
+	PartialSignature []byte
+	// DealRoots are the roots of the deals the operator received by dealer. A dealer sending different (each valid) deals to operators
+	// makes them compute different keys, the echoed roots blame the dealer instead of the operators it deceived
+	DealRoots map[types.OperatorID][32]byte
+}
+
+// GetRoot returns the root used for signing
+func (output *Output) GetRoot() ([32]byte, error) {
+	byts, err := output.Encode()
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not encode output")
+	}
+	return sha256.Sum256(byts), nil
+}
+
+// Encode returns the encoded struct in bytes or error
+func (output *Output) Encode() ([]byte, error) {
+	return json.Marshal(output)
+}
+
+// Decode returns error if decoding failed
+func (output *Output) Decode(data []byte) error {
+	return json.Unmarshal(data, output)
+}
+
+// SignedOutput is an output signed with DKGSigner.SignDKGOutput
+type SignedOutput struct {
+	Output    *Output
+	Signature types.Signature
+}
+
+// Validate returns error if the signed output is invalid
+func (signedOutput *SignedOutput) Validate() error {
+	if signedOutput.Output == nil {
+		return errors.New("output nil")
+	}
+	if len(signedOutput.Signature) != 65 {
+		return errors.New("invalid output signature length")
+	}
+	if len(signedOutput.Output.ValidatorPubKey) != 48 || len(signedOutput.Output.SharePubKey) != 48 {
+		return errors.New("invalid output public key length")
+	}
+	if len(signedOutput.Output.PartialSignature) != 96 {
+		return errors.New("invalid partial signature length")
+	}
+	return nil
+}
+
+// Encode returns the encoded struct in bytes or error
+func (signedOutput *SignedOutput) Encode() ([]byte, error) {
+	return json.Marshal(signedOutput)
+}
+
+// Decode returns error if decoding failed
+func (signedOutput *SignedOutput) Decode(data []byte) error {
+	return json.Unmarshal(data, signedOutput)
+}
+
+// Abort aborts the ceremony, the culprit is the operator who misbehaved
+type Abort struct {
+	Culprit types.OperatorID
+	Reason  string
+}
+
+// Encode returns the encoded struct in bytes or error
+func (abort *Abort) Encode() ([]byte, error) {
+	return json.Marshal(abort)
+}
+
+// Decode returns error if decoding failed
+func (abort *Abort) Decode(data []byte) error {
+	return json.Unmarshal(data, abort)
+}
+
+// Result is a successful ceremony's result for the operator
+type Result struct {
+	Share *types.Share
+	// EncryptedShare is the operator's share secret encrypted to its encryption key
+	EncryptedShare []byte
+	// DepositData is signed by the validator's (reconstructed) threshold signature, nil for a reshare or refresh
+	DepositData *phase0.DepositData
+	// ReshareAuthorization is the validator's signature over the request's signing root reconstructed from the dealers' old shares,
+	// proving a quorum of the old committee authorized the reshare or refresh. It doesn't prove the old shares were deleted,
+	// the old committee's operators still hold them and can sign with them. Nil for a new validator
+	ReshareAuthorization types.Signature `json:",omitempty"`
+	// SignedOutputs are all the operators' signed outputs, proving their participation
+	SignedOutputs map[types.OperatorID]*SignedOutput
+}
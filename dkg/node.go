@@ -0,0 +1,104 @@
+package dkg
+
+import (
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// Node runs the operator's DKG ceremonies, one runner per request
+type Node struct {
+	OperatorID types.OperatorID
+	Config     *Config
+	Runners    map[RequestID]*Runner
+}
+
+func NewNode(operatorID types.OperatorID, config *Config) *Node {
+	return &Node{
+		OperatorID: operatorID,
+		Config:     config,
+		Runners:    make(map[RequestID]*Runner),
+	}
+}
+
+// StartNewDKG starts a ceremony for the request, all the participating operators must start it with the same init
+func (n *Node) StartNewDKG(requestID RequestID, init *Init) error {
+	if n.Runners[requestID] != nil {
+		return errors.New("dkg already started")
+	}
+
+	runner, err := NewRunner(requestID, init, n.OperatorID, n.Config)
+	if err != nil {
+		return errors.Wrap(err, "could not create runner")
+	}
+	n.Runners[requestID] = runner
+	return runner.Start()
+}
+
+// StartNewReshare starts a reshare for the request, all the participating operators (the new committee and the dealers) must start it with the same init.
+// The old share is the dealer's share of the validator, nil for an operator which isn't a dealer
+func (n *Node) StartNewReshare(requestID RequestID, init *Init, oldShare *bls.SecretKey) error {
+	if n.Runners[requestID] != nil {
+		return errors.New("dkg already started")
+	}
+
+	runner, err := NewRunner(requestID, init, n.OperatorID, n.Config)
+	if err != nil {
+		return errors.Wrap(err, "could not create runner")
+	}
+	n.Runners[requestID] = runner
+	return runner.StartReshare(oldShare)
+}
+
+// StartNewRefresh starts a refresh for the request, all the committee's operators must start it with the same init and their old shares
+func (n *Node) StartNewRefresh(requestID RequestID, init *Init, oldShare *bls.SecretKey) error {
+	if n.Runners[requestID] != nil {
+		return errors.New("dkg already started")
+	}
+
+	runner, err := NewRunner(requestID, init, n.OperatorID, n.Config)
+	if err != nil {
+		return errors.Wrap(err, "could not create runner")
+	}
+	n.Runners[requestID] = runner
+	return runner.StartRefresh(oldShare)
+}
+
+// ProcessMessage processes a DKG message for a running ceremony
+func (n *Node) ProcessMessage(signedMsg *types.SignedSSVMessage) error {
+	if err := signedMsg.Validate(); err != nil {
+		return errors.Wrap(err, "invalid SignedSSVMessage")
+	}
+	ssvMsg, err := signedMsg.GetSSVMessageFromData()
+	if err != nil {
+		return err
+	}
+	if ssvMsg.GetType() != types.DKGMsgType {
+		return errors.New("not a dkg message")
+	}
+
+	msg := &Message{}
+	if err := msg.Decode(ssvMsg.GetData()); err != nil {
+		return errors.Wrap(err, "could not decode dkg message")
+	}
+	if err := msg.Validate(); err != nil {
+		return errors.Wrap(err, "invalid dkg message")
+	}
+	if ssvMsg.GetID() != msg.RequestID.MessageID(n.Config.Domain) {
+		return errors.New("message id doesn't match request")
+	}
+
+	runner := n.Runners[msg.RequestID]
+	if runner == nil {
+		return errors.New("no running dkg for request")
+	}
+	return runner.ProcessMsg(signedMsg, msg)
+}
+
+// GetResult returns the request's result, nil if the ceremony didn't finish
+func (n *Node) GetResult(requestID RequestID) *Result {
+	if runner := n.Runners[requestID]; runner != nil {
+		return runner.Result
+	}
+	return nil
+}
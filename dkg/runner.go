@@ -0,0 +1,660 @@
+package dkg
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// Runner runs a single DKG ceremony for the operator (Pedersen DKG, joint Feldman VSS):
+//  1. every operator deals a random polynomial: broadcasts commitments to its coefficients and the evaluations for all operators, encrypted
+//  2. every operator verifies its evaluations from all dealers against the dealers' commitments and sums them into its share.
+//     The validator's public key (and every operator's share public key) is computed from the commitments.
+//     The operator broadcasts its output, signed by its eth address, with a partial signature over the deposit data and the roots of the deals
+//  3. every operator verifies all the outputs (the same deals and keys) and reconstructs the deposit data signature with the validator's public key
+//
+// A reshare (Init.Reshare) runs the same rounds with the old committee's dealers dealing their old shares (committed to by
+// their old share public keys) to the new committee, whose shares are the lagrange interpolation of the dealt evaluations.
+// The validator's public key is unchanged, the outputs' partial signatures are over the request's signing root.
+//
+// A refresh (Init.Refresh) runs the same rounds with every operator dealing a zero secret, shifting the operators' old shares
+// into new shares of the same validator key. The old shares don't combine with the new ones.
+//
+// Any misbehaviour (invalid deal, share or output) aborts the ceremony, blaming the misbehaving operator.
+type Runner struct {
+	RequestID  RequestID
+	Init       *Init
+	OperatorID types.OperatorID
+	Deals      map[types.OperatorID]*Deal
+	Outputs    map[types.OperatorID]*SignedOutput
+	Result     *Result
+	Abort      *Abort
+
+	config       *Config
+	oldShare     *bls.SecretKey
+	coefficients []bls.SecretKey
+	shares       map[types.OperatorID]*bls.SecretKey
+	output       *ceremonyOutput
+}
+
+// ceremonyOutput is the operator's share and the committee's public keys, computed from all the deals
+type ceremonyOutput struct {
+	shareSecret    *bls.SecretKey
+	validatorPK    *bls.PublicKey
+	sharePKs       map[types.OperatorID]*bls.PublicKey
+	signingRoot    []byte
+	encryptedShare []byte
+	dealRoots      map[types.OperatorID][32]byte
+}
+
+func NewRunner(requestID RequestID, init *Init, operatorID types.OperatorID, config *Config) (*Runner, error) {
+	if err := init.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid init")
+	}
+	if !init.IsParticipant(operatorID) {
+		return nil, errors.New("operator not a participant")
+	}
+	return &Runner{
+		RequestID:  requestID,
+		Init:       init,
+		OperatorID: operatorID,
+		Deals:      make(map[types.OperatorID]*Deal),
+		Outputs:    make(map[types.OperatorID]*SignedOutput),
+		config:     config,
+		shares:     make(map[types.OperatorID]*bls.SecretKey),
+	}, nil
+}
+
+// Start deals the operator's random polynomial
+func (r *Runner) Start() error {
+	if r.Init.Reshare != nil || r.Init.Refresh != nil {
+		return errors.New("reshare and refresh must be started with the old share")
+	}
+	types.InitBLS()
+
+	r.coefficients = newPolynomial(r.Init.Threshold)
+	return r.deal(r.coefficients[0].SignByte(dealerIdentity(r.RequestID, r.OperatorID)).Serialize())
+}
+
+// StartReshare deals the operator's old share, nil for an operator of the new committee which isn't a dealer
+func (r *Runner) StartReshare(oldShare *bls.SecretKey) error {
+	if r.Init.Reshare == nil {
+		return errors.New("not a reshare")
+	}
+	if !r.Init.IsDealer(r.OperatorID) {
+		return nil
+	}
+	if oldShare == nil {
+		return errors.New("dealer's old share nil")
+	}
+	types.InitBLS()
+
+	signingRoot, err := RequestSigningRoot(r.RequestID, r.Init, r.config.Domain)
+	if err != nil {
+		return errors.Wrap(err, "could not compute reshare signing root")
+	}
+	r.coefficients = oldShare.GetMasterSecretKey(int(r.Init.Threshold))
+	return r.deal(oldShare.SignByte(signingRoot).Serialize())
+}
+
+// StartRefresh deals a zero secret, the operator's old share is shifted into its new share
+func (r *Runner) StartRefresh(oldShare *bls.SecretKey) error {
+	if r.Init.Refresh == nil {
+		return errors.New("not a refresh")
+	}
+	if oldShare == nil {
+		return errors.New("old share nil")
+	}
+	types.InitBLS()
+
+	if !bytesEqualPK(r.Init.Refresh.CommitteeOperator(r.OperatorID).SharePubKey, oldShare.GetPublicKey()) {
+		return errors.New("old share doesn't match committee")
+	}
+	signingRoot, err := RequestSigningRoot(r.RequestID, r.Init, r.config.Domain)
+	if err != nil {
+		return errors.Wrap(err, "could not compute refresh signing root")
+	}
+	r.oldShare = oldShare
+	r.coefficients = (&bls.SecretKey{}).GetMasterSecretKey(int(r.Init.Threshold))
+	return r.deal(oldShare.SignByte(signingRoot).Serialize())
+}
+
+// deal broadcasts the commitments to the operator's polynomial and its evaluations for the operators
+func (r *Runner) deal(proofOfPossession []byte) error {
+	deal := &Deal{
+		Commitments:       make([][]byte, 0, len(r.coefficients)),
+		ProofOfPossession: proofOfPossession,
+		EncryptedShares:   make(map[types.OperatorID][]byte),
+	}
+	for _, coefficient := range r.coefficients {
+		deal.Commitments = append(deal.Commitments, coefficient.GetPublicKey().Serialize())
+	}
+	for _, operator := range r.Init.Operators {
+		share, err := evaluatePolynomial(r.coefficients, operator.OperatorID)
+		if err != nil {
+			return err
+		}
+		encrypted, err := encryptShare(operator.EncryptionPubKey, operator.OperatorID, r.Init.ValidatorPubKey(), share)
+		if err != nil {
+			return errors.Wrap(err, "could not encrypt share")
+		}
+		deal.EncryptedShares[operator.OperatorID] = encrypted
+	}
+
+	data, err := deal.Encode()
+	if err != nil {
+		return errors.Wrap(err, "could not encode deal")
+	}
+	return r.broadcast(DealMsgType, data)
+}
+
+// ProcessMsg processes a DKG message of the ceremony
+func (r *Runner) ProcessMsg(signedMsg *types.SignedSSVMessage, msg *Message) error {
+	if r.Abort != nil {
+		return errors.New("dkg aborted")
+	}
+	if r.Result != nil {
+		return errors.New("dkg finished")
+	}
+
+	signer := signedMsg.OperatorID
+	if !r.Init.IsParticipant(signer) {
+		return errors.New("signer not a participant")
+	}
+	if err := r.config.SignatureVerifier.Verify(signedMsg, r.Init.SSVOperators()); err != nil {
+		return errors.Wrap(err, "invalid message signature")
+	}
+
+	switch msg.MsgType {
+	case DealMsgType:
+		return r.processDeal(signer, msg.Data)
+	case OutputMsgType:
+		return r.processOutput(signer, msg.Data)
+	case AbortMsgType:
+		return r.processAbort(signer, msg.Data)
+	default:
+		return errors.New("unknown dkg message type")
+	}
+}
+
+func (r *Runner) processDeal(dealer types.OperatorID, data []byte) error {
+	if !r.Init.IsDealer(dealer) {
+		return errors.New("signer not a dealer")
+	}
+	if r.Deals[dealer] != nil {
+		return errors.New("duplicate deal")
+	}
+
+	deal := &Deal{}
+	if err := deal.Decode(data); err != nil {
+		return r.abort(dealer, "could not decode deal")
+	}
+	if err := deal.Validate(r.Init); err != nil {
+		return r.abort(dealer, "invalid deal: "+err.Error())
+	}
+	proofPK, proofMsg := deal.Commitments[0], dealerIdentity(r.RequestID, dealer)
+	if r.Init.Reshare != nil || r.Init.Refresh != nil {
+		signingRoot, err := RequestSigningRoot(r.RequestID, r.Init, r.config.Domain)
+		if err != nil {
+			return errors.Wrap(err, "could not compute request signing root")
+		}
+		proofMsg = signingRoot
+	}
+	if r.Init.Reshare != nil {
+		// the dealer must deal its old share
+		if !bytes.Equal(deal.Commitments[0], r.Init.Reshare.OldOperator(dealer).SharePubKey) {
+			return r.abort(dealer, "invalid dealer commitment")
+		}
+	}
+	if r.Init.Refresh != nil {
+		// the dealer must deal a zero secret, proving possession of its old share
+		if !bytes.Equal(deal.Commitments[0], zeroPublicKey()) {
+			return r.abort(dealer, "invalid dealer commitment")
+		}
+		proofPK = r.Init.Refresh.CommitteeOperator(dealer).SharePubKey
+	}
+	if err := verifyProofOfPossession(deal, proofPK, proofMsg); err != nil {
+		return r.abort(dealer, err.Error())
+	}
+
+	// a dealer not in the new committee gets no share
+	if r.Init.Operator(r.OperatorID) != nil {
+		share, err := decryptShare(r.config.EncryptionKey, r.OperatorID, r.Init.ValidatorPubKey(), deal.EncryptedShares[r.OperatorID])
+		if err != nil {
+			return r.abort(dealer, "could not decrypt share")
+		}
+		expectedPK, err := evaluateCommitments(deal.Commitments, r.OperatorID)
+		if err != nil {
+			return r.abort(dealer, "invalid deal: "+err.Error())
+		}
+		if !share.GetPublicKey().IsEqual(expectedPK) {
+			return r.abort(dealer, "invalid share")
+		}
+		r.shares[dealer] = share
+	}
+	r.Deals[dealer] = deal
+
+	if len(r.Deals) < len(r.Init.Dealers()) {
+		return nil
+	}
+	return r.broadcastOutput()
+}
+
+// broadcastOutput computes the operator's share and the committee's public keys from all the deals and broadcasts the operator's output
+func (r *Runner) broadcastOutput() error {
+	var out *ceremonyOutput
+	var err error
+	switch {
+	case r.Init.Reshare != nil:
+		out, err = r.combineReshare()
+	case r.Init.Refresh != nil:
+		out, err = r.combineRefresh()
+	default:
+		out, err = r.combineDeals()
+	}
+	if err != nil {
+		return err
+	}
+	out.dealRoots = make(map[types.OperatorID][32]byte)
+	for dealer, deal := range r.Deals {
+		out.dealRoots[dealer], err = deal.GetRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not compute deal root")
+		}
+	}
+	r.output = out
+
+	// a dealer not in the new committee only verifies the outputs
+	if operator := r.Init.Operator(r.OperatorID); operator != nil {
+		out.encryptedShare, err = encryptShare(operator.EncryptionPubKey, r.OperatorID, out.validatorPK.Serialize(), out.shareSecret)
+		if err != nil {
+			return errors.Wrap(err, "could not encrypt share")
+		}
+
+		output := &Output{
+			RequestID:        r.RequestID,
+			OperatorID:       r.OperatorID,
+			ValidatorPubKey:  out.validatorPK.Serialize(),
+			SharePubKey:      out.sharePKs[r.OperatorID].Serialize(),
+			EncryptedShare:   out.encryptedShare,
+			PartialSignature: out.shareSecret.SignByte(out.signingRoot).Serialize(),
+			DealRoots:        out.dealRoots,
+		}
+		sig, err := r.config.Signer.SignDKGOutput(output, operator.ETHAddress)
+		if err != nil {
+			return errors.Wrap(err, "could not sign output")
+		}
+		data, err := (&SignedOutput{Output: output, Signature: sig}).Encode()
+		if err != nil {
+			return errors.Wrap(err, "could not encode output")
+		}
+		if err := r.broadcast(OutputMsgType, data); err != nil {
+			return err
+		}
+	}
+
+	// outputs received before all the deals
+	for _, signer := range sortedSigners(r.Outputs) {
+		if err := r.verifyOutput(signer, r.Outputs[signer]); err != nil {
+			return err
+		}
+	}
+	return r.tryFinish()
+}
+
+// combineDeals sums the dealers' polynomials, the validator's secret is the sum of their secrets
+func (r *Runner) combineDeals() (*ceremonyOutput, error) {
+	out := &ceremonyOutput{
+		shareSecret: &bls.SecretKey{},
+		validatorPK: &bls.PublicKey{},
+		sharePKs:    make(map[types.OperatorID]*bls.PublicKey),
+	}
+	for _, operator := range r.Init.Operators {
+		deal := r.Deals[operator.OperatorID]
+
+		out.shareSecret.Add(r.shares[operator.OperatorID])
+
+		dealerPK := &bls.PublicKey{}
+		if err := dealerPK.Deserialize(deal.Commitments[0]); err != nil {
+			return nil, errors.Wrap(err, "could not deserialize commitment")
+		}
+		out.validatorPK.Add(dealerPK)
+
+		for _, shareOperator := range r.Init.Operators {
+			pk, err := evaluateCommitments(deal.Commitments, shareOperator.OperatorID)
+			if err != nil {
+				return nil, err
+			}
+			if out.sharePKs[shareOperator.OperatorID] == nil {
+				out.sharePKs[shareOperator.OperatorID] = pk
+			} else {
+				out.sharePKs[shareOperator.OperatorID].Add(pk)
+			}
+		}
+	}
+
+	signingRoot, _, err := types.GenerateETHDepositData(
+		out.validatorPK.Serialize(),
+		r.Init.WithdrawalCredentials,
+		r.Init.Fork,
+		types.DomainDeposit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate deposit data")
+	}
+	out.signingRoot = signingRoot
+	return out, nil
+}
+
+// combineReshare interpolates the dealers' polynomials, the validator's secret is the interpolation of the dealers' old shares
+func (r *Runner) combineReshare() (*ceremonyOutput, error) {
+	dealers := r.Init.Reshare.Dealers
+	out := &ceremonyOutput{
+		sharePKs: make(map[types.OperatorID]*bls.PublicKey),
+	}
+
+	if r.Init.Operator(r.OperatorID) != nil {
+		shareSecret, err := interpolateSecrets(r.shares, dealers)
+		if err != nil {
+			return nil, err
+		}
+		out.shareSecret = shareSecret
+	}
+
+	dealerPKs := make(map[types.OperatorID]*bls.PublicKey)
+	for _, dealer := range dealers {
+		dealerPKs[dealer] = &bls.PublicKey{}
+		if err := dealerPKs[dealer].Deserialize(r.Deals[dealer].Commitments[0]); err != nil {
+			return nil, errors.Wrap(err, "could not deserialize commitment")
+		}
+	}
+	validatorPK, err := interpolatePublicKeys(dealerPKs, dealers)
+	if err != nil {
+		return nil, err
+	}
+	if !bytesEqualPK(r.Init.Reshare.ValidatorPubKey, validatorPK) {
+		return nil, errors.New("old committee doesn't match validator public key")
+	}
+	out.validatorPK = validatorPK
+
+	for _, shareOperator := range r.Init.Operators {
+		evaluations := make(map[types.OperatorID]*bls.PublicKey)
+		for _, dealer := range dealers {
+			pk, err := evaluateCommitments(r.Deals[dealer].Commitments, shareOperator.OperatorID)
+			if err != nil {
+				return nil, err
+			}
+			evaluations[dealer] = pk
+		}
+		sharePK, err := interpolatePublicKeys(evaluations, dealers)
+		if err != nil {
+			return nil, err
+		}
+		out.sharePKs[shareOperator.OperatorID] = sharePK
+	}
+
+	out.signingRoot, err = RequestSigningRoot(r.RequestID, r.Init, r.config.Domain)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute reshare signing root")
+	}
+	return out, nil
+}
+
+// combineRefresh shifts the committee's old shares by the dealers' zero secret polynomials, the validator's secret is unchanged
+func (r *Runner) combineRefresh() (*ceremonyOutput, error) {
+	out := &ceremonyOutput{
+		shareSecret: &bls.SecretKey{},
+		validatorPK: &bls.PublicKey{},
+		sharePKs:    make(map[types.OperatorID]*bls.PublicKey),
+	}
+	if err := out.validatorPK.Deserialize(r.Init.Refresh.ValidatorPubKey); err != nil {
+		return nil, errors.Wrap(err, "could not deserialize validator public key")
+	}
+
+	out.shareSecret.Add(r.oldShare)
+	for _, operator := range r.Init.Operators {
+		out.shareSecret.Add(r.shares[operator.OperatorID])
+	}
+
+	for _, shareOperator := range r.Init.Operators {
+		sharePK := &bls.PublicKey{}
+		if err := sharePK.Deserialize(r.Init.Refresh.CommitteeOperator(shareOperator.OperatorID).SharePubKey); err != nil {
+			return nil, errors.Wrap(err, "could not deserialize share public key")
+		}
+		for _, operator := range r.Init.Operators {
+			pk, err := evaluateCommitments(r.Deals[operator.OperatorID].Commitments, shareOperator.OperatorID)
+			if err != nil {
+				return nil, err
+			}
+			sharePK.Add(pk)
+		}
+		out.sharePKs[shareOperator.OperatorID] = sharePK
+	}
+
+	signingRoot, err := RequestSigningRoot(r.RequestID, r.Init, r.config.Domain)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute refresh signing root")
+	}
+	out.signingRoot = signingRoot
+	return out, nil
+}
+
+func (r *Runner) processOutput(signer types.OperatorID, data []byte) error {
+	if r.Init.Operator(signer) == nil {
+		return errors.New("signer not in committee")
+	}
+	if r.Outputs[signer] != nil {
+		return errors.New("duplicate output")
+	}
+
+	signedOutput := &SignedOutput{}
+	if err := signedOutput.Decode(data); err != nil {
+		return r.abort(signer, "could not decode output")
+	}
+	if err := signedOutput.Validate(); err != nil {
+		return r.abort(signer, "invalid output: "+err.Error())
+	}
+	if signedOutput.Output.OperatorID != signer || signedOutput.Output.RequestID != r.RequestID {
+		return r.abort(signer, "invalid output: wrong operator or request")
+	}
+	if err := verifyOutputSignature(signedOutput, r.Init.Operator(signer).ETHAddress); err != nil {
+		return r.abort(signer, "invalid output signature")
+	}
+	r.Outputs[signer] = signedOutput
+
+	// verified once all the deals are received
+	if r.output == nil {
+		return nil
+	}
+	if err := r.verifyOutput(signer, signedOutput); err != nil {
+		return err
+	}
+	return r.tryFinish()
+}
+
+// verifyOutput verifies the output matches the committee's public keys computed from the deals.
+// The deals are checked first, a dealer equivocating would otherwise have the honest operators blame each other for their keys
+func (r *Runner) verifyOutput(signer types.OperatorID, signedOutput *SignedOutput) error {
+	output := signedOutput.Output
+	if len(output.DealRoots) != len(r.output.dealRoots) {
+		return r.abort(signer, "invalid output: wrong number of deal roots")
+	}
+	for _, dealer := range r.Init.Dealers() {
+		root, found := output.DealRoots[dealer]
+		if !found {
+			return r.abort(signer, "invalid output: missing deal root")
+		}
+		if root != r.output.dealRoots[dealer] {
+			return r.abort(dealer, "dealer equivocated")
+		}
+	}
+	if !bytesEqualPK(output.ValidatorPubKey, r.output.validatorPK) {
+		return r.abort(signer, "invalid validator public key")
+	}
+	if !bytesEqualPK(output.SharePubKey, r.output.sharePKs[signer]) {
+		return r.abort(signer, "invalid share public key")
+	}
+	sig := &bls.Sign{}
+	if err := sig.Deserialize(output.PartialSignature); err != nil {
+		return r.abort(signer, "invalid partial signature")
+	}
+	if !sig.VerifyByte(r.output.sharePKs[signer], r.output.signingRoot) {
+		return r.abort(signer, "invalid partial signature")
+	}
+	return nil
+}
+
+// tryFinish reconstructs the validator's signature once all the outputs are received and verified
+func (r *Runner) tryFinish() error {
+	if len(r.Outputs) < len(r.Init.Operators) {
+		return nil
+	}
+
+	partialSigs := make(map[types.OperatorID][]byte)
+	for signer, signedOutput := range r.Outputs {
+		partialSigs[signer] = signedOutput.Output.PartialSignature
+	}
+	sig, err := types.ReconstructSignatures(partialSigs)
+	if err != nil {
+		return errors.Wrap(err, "could not reconstruct signature")
+	}
+	validatorPK := r.output.validatorPK.Serialize()
+	signingRoot := [32]byte{}
+	copy(signingRoot[:], r.output.signingRoot)
+	if err := types.VerifyReconstructedSignature(sig, validatorPK, signingRoot); err != nil {
+		return errors.Wrap(err, "could not verify signature")
+	}
+
+	result := &Result{
+		EncryptedShare: r.output.encryptedShare,
+		SignedOutputs:  r.Outputs,
+	}
+	if r.Init.Reshare != nil || r.Init.Refresh != nil {
+		authorization, err := r.reconstructReshareAuthorization(validatorPK, signingRoot)
+		if err != nil {
+			return err
+		}
+		result.ReshareAuthorization = authorization
+	} else {
+		_, depositData, err := types.GenerateETHDepositData(validatorPK, r.Init.WithdrawalCredentials, r.Init.Fork, types.DomainDeposit)
+		if err != nil {
+			return errors.Wrap(err, "could not generate deposit data")
+		}
+		copy(depositData.Signature[:], sig.Serialize())
+		result.DepositData = depositData
+	}
+
+	// a dealer not in the new committee has no share
+	if r.Init.Operator(r.OperatorID) != nil {
+		quorum, partialQuorum := types.ComputeQuorum(uint64(len(r.Init.Operators)))
+		share := &types.Share{
+			OperatorID:          r.OperatorID,
+			ValidatorPubKey:     validatorPK,
+			SharePubKey:         r.output.sharePKs[r.OperatorID].Serialize(),
+			Committee:           make([]*types.Operator, 0, len(r.Init.Operators)),
+			Quorum:              quorum,
+			PartialQuorum:       partialQuorum,
+			DomainType:          r.config.Domain,
+			FeeRecipientAddress: r.Init.Owner,
+		}
+		for _, operator := range r.Init.Operators {
+			share.Committee = append(share.Committee, &types.Operator{
+				OperatorID:         operator.OperatorID,
+				SharePubKey:        r.output.sharePKs[operator.OperatorID].Serialize(),
+				SSVOperatorPubKey:  operator.SSVOperatorPubKey,
+				SSVOperatorKeyType: operator.SSVOperatorKeyType,
+			})
+		}
+		result.Share = share
+	}
+
+	r.Result = result
+	return nil
+}
+
+// reconstructReshareAuthorization reconstructs the validator's signature over the request's signing root from the dealers' proofs of possession
+func (r *Runner) reconstructReshareAuthorization(validatorPK []byte, signingRoot [32]byte) (types.Signature, error) {
+	proofs := make(map[types.OperatorID][]byte)
+	for dealer, deal := range r.Deals {
+		proofs[dealer] = deal.ProofOfPossession
+	}
+	sig, err := types.ReconstructSignatures(proofs)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reconstruct reshare authorization")
+	}
+	if err := types.VerifyReconstructedSignature(sig, validatorPK, signingRoot); err != nil {
+		return nil, errors.Wrap(err, "could not verify reshare authorization")
+	}
+	return sig.Serialize(), nil
+}
+
+// processAbort stops the ceremony, any operator can abort it (as it can by not participating)
+func (r *Runner) processAbort(signer types.OperatorID, data []byte) error {
+	abort := &Abort{}
+	if err := abort.Decode(data); err != nil {
+		return errors.Wrap(err, "could not decode abort")
+	}
+	r.Abort = abort
+	return errors.Errorf("dkg aborted by operator %d, operator %d misbehaved: %s", signer, abort.Culprit, abort.Reason)
+}
+
+// abort stops the ceremony and broadcasts the culprit to the other operators
+func (r *Runner) abort(culprit types.OperatorID, reason string) error {
+	r.Abort = &Abort{
+		Culprit: culprit,
+		Reason:  reason,
+	}
+	data, err := r.Abort.Encode()
+	if err != nil {
+		return errors.Wrap(err, "could not encode abort")
+	}
+	if err := r.broadcast(AbortMsgType, data); err != nil {
+		return err
+	}
+	return errors.Errorf("dkg aborted, operator %d misbehaved: %s", culprit, reason)
+}
+
+func (r *Runner) broadcast(msgType MsgType, data []byte) error {
+	msg := &Message{
+		MsgType:   msgType,
+		RequestID: r.RequestID,
+		Data:      data,
+	}
+	byts, err := msg.Encode()
+	if err != nil {
+		return errors.Wrap(err, "could not encode message")
+	}
+
+	ssvMsg := &types.SSVMessage{
+		MsgType: types.DKGMsgType,
+		MsgID:   r.RequestID.MessageID(r.config.Domain),
+		Data:    byts,
+	}
+	msgToBroadcast, err := types.SSVMessageToSignedSSVMessage(ssvMsg, r.OperatorID, r.config.OperatorSigner.SignSSVMessage)
+	if err != nil {
+		return errors.Wrap(err, "could not create SignedSSVMessage from SSVMessage")
+	}
+	return r.config.Network.Broadcast(ssvMsg.GetID(), msgToBroadcast)
+}
+
+func bytesEqualPK(byts []byte, pk *bls.PublicKey) bool {
+	other := &bls.PublicKey{}
+	if err := other.Deserialize(byts); err != nil {
+		return false
+	}
+	return other.IsEqual(pk)
+}
+
+func sortedSigners(outputs map[types.OperatorID]*SignedOutput) []types.OperatorID {
+	ret := make([]types.OperatorID, 0, len(outputs))
+	for signer := range outputs {
+		ret = append(ret, signer)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i] < ret[j]
+	})
+	return ret
+}
@@ -0,0 +1,64 @@
+package spectest
+
+import (
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/ceremony"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/dealer"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/refresh"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/reshare"
+)
+
+type SpecTest interface {
+	TestName() string
+	Run(t *testing.T)
+}
+
+var AllTests = []SpecTest{
+	ceremony.HappyFlow4Operators(),
+	ceremony.HappyFlow7Operators(),
+	ceremony.HappyFlow10Operators(),
+	ceremony.HappyFlow13Operators(),
+	ceremony.MinThreshold(),
+	ceremony.ThresholdTooLow(),
+	ceremony.ThresholdTooHigh(),
+
+	ceremony.InvalidShareAbort(),
+	ceremony.InvalidShareSingleVictim(),
+	ceremony.LegacyShareAbort(),
+	ceremony.InvalidProofOfPossessionAbort(),
+	ceremony.MissingCommitmentAbort(),
+	ceremony.EquivocationAbort(),
+	ceremony.WrongValidatorPubKeyAbort(),
+	ceremony.InvalidDepositSignatureAbort(),
+	ceremony.InvalidOutputSignatureAbort(),
+
+	reshare.SameCommittee(),
+	reshare.AllDealers(),
+	reshare.GrowCommittee(),
+	reshare.ShrinkCommittee(),
+	reshare.DealersNotQuorum(),
+	reshare.DealerNotInOldCommittee(),
+	reshare.WrongOldShare(),
+	reshare.InvalidShareAbort(),
+	reshare.InvalidPartialSignatureAbort(),
+
+	refresh.Refresh4Operators(),
+	refresh.Refresh7Operators(),
+	refresh.Refresh13Operators(),
+	refresh.WrongOldShare(),
+	refresh.NonZeroSecretAbort(),
+	refresh.InvalidProofOfPossessionAbort(),
+	refresh.InvalidShareAbort(),
+
+	dealer.SplitTestingValidator(),
+	dealer.Split7Operators(),
+	dealer.Split10Operators(),
+	dealer.Split13Operators(),
+	dealer.MinThreshold(),
+	dealer.WeightedCommittee(),
+	dealer.WeightAboveF(),
+	dealer.UnsortedOperators(),
+	dealer.ThresholdTooHigh(),
+	dealer.ThresholdAboveMinQuorum(),
+}
@@ -0,0 +1,76 @@
+package spectest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/ceremony"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/dealer"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/refresh"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/reshare"
+)
+
+func TestAll(t *testing.T) {
+	for _, test := range AllTests {
+		t.Run(test.TestName(), func(t *testing.T) {
+			test.Run(t)
+		})
+	}
+}
+
+func TestJson(t *testing.T) {
+	basedir, _ := os.Getwd()
+	path := filepath.Join(basedir, "generate", "tests.json")
+	untypedTests := map[string]interface{}{}
+	byteValue, err := os.ReadFile(path)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	if err := json.Unmarshal(byteValue, &untypedTests); err != nil {
+		panic(err.Error())
+	}
+
+	fmt.Printf("running %d tests\n", len(untypedTests))
+	for name, test := range untypedTests {
+		testName := test.(map[string]interface{})["Name"].(string)
+		t.Run(testName, func(t *testing.T) {
+			testType := strings.Split(name, "_")[0]
+			switch testType {
+			case reflect.TypeOf(&ceremony.CeremonySpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &ceremony.CeremonySpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&dealer.SplitSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &dealer.SplitSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&refresh.RefreshSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &refresh.RefreshSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&reshare.ReshareSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &reshare.ReshareSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			default:
+				t.Fatalf("unknown test")
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package ceremony
+
+import "github.com/ssvlabs/ssv-spec/dkg"
+
+// InvalidShareAbort tests a dealer sending invalid shares to all the operators, all abort
+func InvalidShareAbort() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "invalid share",
+		OperatorCount: 4,
+		Misbehaviour: &Misbehaviour{
+			Operator: 2,
+			Type:     InvalidShare,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 2, Reason: "invalid share"},
+	}
+}
+
+// InvalidShareSingleVictim tests a dealer sending an invalid share to a single operator, it aborts the ceremony for all
+func InvalidShareSingleVictim() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "invalid share single victim",
+		OperatorCount: 7,
+		Misbehaviour: &Misbehaviour{
+			Operator: 3,
+			Type:     InvalidShare,
+			Victim:   5,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 3, Reason: "invalid share"},
+	}
+}
+
+// LegacyShareAbort tests a dealer sending a legacy PKCS1v15 encrypted share, it isn't decrypted (it would be a padding oracle) and all abort
+func LegacyShareAbort() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "legacy share",
+		OperatorCount: 4,
+		Misbehaviour: &Misbehaviour{
+			Operator: 2,
+			Type:     LegacyShare,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 2, Reason: "could not decrypt share"},
+	}
+}
+
+// InvalidProofOfPossessionAbort tests a dealer not proving possession of its secret, all abort
+func InvalidProofOfPossessionAbort() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "invalid proof of possession",
+		OperatorCount: 4,
+		Misbehaviour: &Misbehaviour{
+			Operator: 1,
+			Type:     InvalidProofOfPossession,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 1, Reason: "invalid proof of possession"},
+	}
+}
+
+// MissingCommitmentAbort tests a dealer committing to a polynomial of a lower degree, all abort
+func MissingCommitmentAbort() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "missing commitment",
+		OperatorCount: 10,
+		Misbehaviour: &Misbehaviour{
+			Operator: 4,
+			Type:     MissingCommitment,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 4, Reason: "invalid deal: invalid number of commitments"},
+	}
+}
+
+// EquivocationAbort tests a dealer sending another valid deal to a single operator, the operators' outputs echo different deals.
+// All abort blaming the dealer, not the deceived operator whose keys differ from the others'
+func EquivocationAbort() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "equivocation",
+		OperatorCount: 7,
+		Misbehaviour: &Misbehaviour{
+			Operator: 2,
+			Type:     Equivocation,
+			Victim:   6,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 2, Reason: "dealer equivocated"},
+	}
+}
+
+// WrongValidatorPubKeyAbort tests an operator outputting another validator public key, all abort
+func WrongValidatorPubKeyAbort() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "wrong validator public key",
+		OperatorCount: 4,
+		Misbehaviour: &Misbehaviour{
+			Operator: 4,
+			Type:     WrongValidatorPubKey,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 4, Reason: "invalid validator public key"},
+	}
+}
+
+// InvalidDepositSignatureAbort tests an operator outputting an invalid partial signature, all abort
+func InvalidDepositSignatureAbort() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "invalid deposit signature",
+		OperatorCount: 13,
+		Misbehaviour: &Misbehaviour{
+			Operator: 13,
+			Type:     InvalidDepositSignature,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 13, Reason: "invalid partial signature"},
+	}
+}
+
+// InvalidOutputSignatureAbort tests an operator's output signed by another operator's eth address, all abort
+func InvalidOutputSignatureAbort() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "invalid output signature",
+		OperatorCount: 4,
+		Misbehaviour: &Misbehaviour{
+			Operator: 2,
+			Type:     InvalidOutputSignature,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 2, Reason: "invalid output signature"},
+	}
+}
@@ -0,0 +1,62 @@
+package ceremony
+
+// HappyFlow4Operators tests a full ceremony between 4 operators with threshold 3
+func HappyFlow4Operators() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "happy flow 4 operators",
+		OperatorCount: 4,
+	}
+}
+
+// HappyFlow7Operators tests a full ceremony between 7 operators with threshold 5
+func HappyFlow7Operators() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "happy flow 7 operators",
+		OperatorCount: 7,
+	}
+}
+
+// HappyFlow10Operators tests a full ceremony between 10 operators with threshold 7
+func HappyFlow10Operators() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "happy flow 10 operators",
+		OperatorCount: 10,
+	}
+}
+
+// HappyFlow13Operators tests a full ceremony between 13 operators with threshold 9
+func HappyFlow13Operators() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "happy flow 13 operators",
+		OperatorCount: 13,
+	}
+}
+
+// MinThreshold tests a full ceremony between 4 operators with threshold f+1 = 2
+func MinThreshold() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "min threshold",
+		OperatorCount: 4,
+		Threshold:     2,
+	}
+}
+
+// ThresholdTooLow tests a ceremony with threshold f, f operators could reconstruct the validator's key
+func ThresholdTooLow() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "threshold too low",
+		OperatorCount: 4,
+		Threshold:     1,
+		ExpectedError: "could not create runner: invalid init: invalid threshold",
+	}
+}
+
+// ThresholdTooHigh tests a ceremony with threshold above quorum, a quorum of operators couldn't reconstruct a signature
+func ThresholdTooHigh() *CeremonySpecTest {
+	return &CeremonySpecTest{
+		Name:          "threshold too high",
+		OperatorCount: 7,
+		Threshold:     6,
+		ExpectedError: "could not create runner: invalid init: invalid threshold",
+	}
+}
@@ -0,0 +1,201 @@
+package ceremony
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/dkg"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+type MisbehaviourType string
+
+const (
+	// InvalidShare deals a share not matching the dealer's commitments
+	InvalidShare MisbehaviourType = "invalid share"
+	// LegacyShare deals a share encrypted as a legacy PKCS1v15 payload instead of an envelope
+	LegacyShare MisbehaviourType = "legacy share"
+	// InvalidProofOfPossession deals a proof of possession not signed by the dealer's secret
+	InvalidProofOfPossession MisbehaviourType = "invalid proof of possession"
+	// MissingCommitment deals less commitments than the threshold
+	MissingCommitment MisbehaviourType = "missing commitment"
+	// WrongSecretCommitment deals a commitment to another secret than the dealer's
+	WrongSecretCommitment MisbehaviourType = "wrong secret commitment"
+	// Equivocation deals another valid polynomial than the one dealt to the other operators
+	Equivocation MisbehaviourType = "equivocation"
+	// WrongValidatorPubKey outputs another validator public key
+	WrongValidatorPubKey MisbehaviourType = "wrong validator public key"
+	// InvalidDepositSignature outputs a partial signature not signed by the operator's share
+	InvalidDepositSignature MisbehaviourType = "invalid deposit signature"
+	// InvalidOutputSignature outputs a signature by another operator's eth address
+	InvalidOutputSignature MisbehaviourType = "invalid output signature"
+)
+
+// Misbehaviour tampers with the operator's broadcasted messages, delivered to the other operators
+type Misbehaviour struct {
+	Operator types.OperatorID
+	Type     MisbehaviourType
+	// Victim is the only operator receiving the tampered message, all the other operators if 0
+	Victim types.OperatorID
+}
+
+// TamperF returns the network's tamper function for the ceremony (init), nil if there's no misbehaviour
+func (m *Misbehaviour) TamperF(keySet func(types.OperatorID) *testingutils.TestKeySet, init *dkg.Init) tests.TamperF {
+	if m == nil {
+		return nil
+	}
+	return func(msg *types.SignedSSVMessage, to types.OperatorID) *types.SignedSSVMessage {
+		return m.Tamper(keySet, init, msg, to)
+	}
+}
+
+// Tamper returns the message of the ceremony (init) delivered to the operator, re-signed by the misbehaving operator if tampered with.
+// keySet returns the key set holding the operator's keys
+func (m *Misbehaviour) Tamper(keySet func(types.OperatorID) *testingutils.TestKeySet, init *dkg.Init, signedMsg *types.SignedSSVMessage, to types.OperatorID) *types.SignedSSVMessage {
+	if signedMsg.OperatorID != m.Operator || to == m.Operator || (m.Victim != 0 && to != m.Victim) {
+		return signedMsg
+	}
+
+	ssvMsg, err := signedMsg.GetSSVMessageFromData()
+	if err != nil {
+		panic(err.Error())
+	}
+	msg := &dkg.Message{}
+	if err := msg.Decode(ssvMsg.Data); err != nil {
+		panic(err.Error())
+	}
+
+	switch msg.MsgType {
+	case dkg.DealMsgType:
+		msg.Data = m.tamperDeal(keySet(to), init, msg.RequestID, msg.Data, to)
+	case dkg.OutputMsgType:
+		msg.Data = m.tamperOutput(keySet, msg.Data)
+	default:
+		return signedMsg
+	}
+
+	ssvMsg.Data, err = msg.Encode()
+	if err != nil {
+		panic(err.Error())
+	}
+	ret, err := types.SSVMessageToSignedSSVMessage(ssvMsg, m.Operator, testingutils.NewTestingOperatorSigner(keySet(m.Operator), m.Operator).SignSSVMessage)
+	if err != nil {
+		panic(err.Error())
+	}
+	return ret
+}
+
+func (m *Misbehaviour) tamperDeal(ks *testingutils.TestKeySet, init *dkg.Init, requestID dkg.RequestID, data []byte, to types.OperatorID) []byte {
+	deal := &dkg.Deal{}
+	if err := deal.Decode(data); err != nil {
+		panic(err.Error())
+	}
+
+	switch m.Type {
+	case InvalidShare:
+		encrypted, err := types.EncryptShare(&ks.DKGOperators[to].EncryptionKey.PublicKey, to, init.ValidatorPubKey(), randomSecret().Serialize())
+		if err != nil {
+			panic(err.Error())
+		}
+		deal.EncryptedShares[to] = encrypted
+	case LegacyShare:
+		encrypted, err := types.Encrypt(&ks.DKGOperators[to].EncryptionKey.PublicKey, randomSecret().Serialize())
+		if err != nil {
+			panic(err.Error())
+		}
+		deal.EncryptedShares[to] = encrypted
+	case InvalidProofOfPossession:
+		deal.ProofOfPossession = randomSecret().SignByte(deal.ProofOfPossession).Serialize()
+	case MissingCommitment:
+		deal.Commitments = deal.Commitments[:len(deal.Commitments)-1]
+	case WrongSecretCommitment:
+		deal.Commitments[0] = randomSecret().GetPublicKey().Serialize()
+	case Equivocation:
+		deal = m.equivocatedDeal(ks, init, requestID)
+	default:
+		return data
+	}
+
+	ret, err := deal.Encode()
+	if err != nil {
+		panic(err.Error())
+	}
+	return ret
+}
+
+// equivocatedDeal returns a valid deal of a random polynomial, the victim can't tell it from the other operators' deal
+func (m *Misbehaviour) equivocatedDeal(ks *testingutils.TestKeySet, init *dkg.Init, requestID dkg.RequestID) *dkg.Deal {
+	coefficients := randomSecret().GetMasterSecretKey(int(init.Threshold))
+
+	// the dealer's identity signed by the proof of possession, see dkg.dealerIdentity
+	identity := binary.LittleEndian.AppendUint64(append([]byte{}, requestID[:]...), m.Operator)
+	identityRoot := sha256.Sum256(identity)
+
+	deal := &dkg.Deal{
+		Commitments:       make([][]byte, 0, len(coefficients)),
+		ProofOfPossession: coefficients[0].SignByte(identityRoot[:]).Serialize(),
+		EncryptedShares:   make(map[types.OperatorID][]byte),
+	}
+	for _, coefficient := range coefficients {
+		deal.Commitments = append(deal.Commitments, coefficient.GetPublicKey().Serialize())
+	}
+	for _, operator := range init.Operators {
+		id := bls.ID{}
+		if err := id.SetDecString(fmt.Sprintf("%d", operator.OperatorID)); err != nil {
+			panic(err.Error())
+		}
+		share := bls.SecretKey{}
+		if err := share.Set(coefficients, &id); err != nil {
+			panic(err.Error())
+		}
+		encrypted, err := types.EncryptShare(&ks.DKGOperators[operator.OperatorID].EncryptionKey.PublicKey, operator.OperatorID, init.ValidatorPubKey(), share.Serialize())
+		if err != nil {
+			panic(err.Error())
+		}
+		deal.EncryptedShares[operator.OperatorID] = encrypted
+	}
+	return deal
+}
+
+func (m *Misbehaviour) tamperOutput(keySet func(types.OperatorID) *testingutils.TestKeySet, data []byte) []byte {
+	signedOutput := &dkg.SignedOutput{}
+	if err := signedOutput.Decode(data); err != nil {
+		panic(err.Error())
+	}
+
+	signer := keySet(m.Operator).DKGOperators[m.Operator].ETHAddress
+	switch m.Type {
+	case WrongValidatorPubKey:
+		signedOutput.Output.ValidatorPubKey = randomSecret().GetPublicKey().Serialize()
+	case InvalidDepositSignature:
+		signedOutput.Output.PartialSignature = randomSecret().SignByte(signedOutput.Output.PartialSignature).Serialize()
+	case InvalidOutputSignature:
+		other := m.Operator%uint64(len(keySet(m.Operator).DKGOperators)) + 1
+		signer = keySet(other).DKGOperators[other].ETHAddress
+	default:
+		return data
+	}
+
+	sig, err := testingutils.NewTestingKeyManager().SignDKGOutput(signedOutput.Output, signer)
+	if err != nil {
+		panic(err.Error())
+	}
+	signedOutput.Signature = sig
+
+	ret, err := signedOutput.Encode()
+	if err != nil {
+		panic(err.Error())
+	}
+	return ret
+}
+
+func randomSecret() *bls.SecretKey {
+	types.InitBLS()
+	ret := &bls.SecretKey{}
+	ret.SetByCSPRNG()
+	return ret
+}
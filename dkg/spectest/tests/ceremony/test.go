@@ -0,0 +1,119 @@
+package ceremony
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/dkg"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// CeremonySpecTest runs a full DKG ceremony between the key set's operators over an in order network, delivering every message to all
+// the operators (including the sender). A misbehaving operator runs the protocol but tampers with its broadcasted messages.
+type CeremonySpecTest struct {
+	Name          string
+	OperatorCount int
+	// Threshold overrides the key set's threshold if not 0
+	Threshold    uint64
+	Misbehaviour *Misbehaviour
+	// ExpectedError is the error starting the ceremony
+	ExpectedError string
+	// ExpectedAbort is the abort of every honest operator, nil if the ceremony should finish
+	ExpectedAbort *dkg.Abort
+}
+
+func (test *CeremonySpecTest) TestName() string {
+	return "dkg ceremony " + test.Name
+}
+
+func (test *CeremonySpecTest) Run(t *testing.T) {
+	ks := tests.KeySetForCount(test.OperatorCount)
+	requestID := testingutils.TestingDKGRequestID(ks)
+	init := testingutils.TestingDKGInit(ks)
+	if test.Threshold != 0 {
+		init.Threshold = test.Threshold
+	}
+
+	keySet := func(types.OperatorID) *testingutils.TestKeySet { return ks }
+	operatorIDs := tests.OperatorIDs(init)
+	network, nodes := tests.NewNodes(operatorIDs, keySet)
+
+	for _, id := range operatorIDs {
+		err := nodes[id].StartNewDKG(requestID, init)
+		if len(test.ExpectedError) != 0 {
+			require.EqualError(t, err, test.ExpectedError)
+			return
+		}
+		require.NoError(t, err)
+	}
+
+	network.Deliver(nodes, operatorIDs, test.Misbehaviour.TamperF(keySet, init))
+
+	if test.ExpectedAbort != nil {
+		tests.RequireAbort(t, nodes, operatorIDs, requestID, test.Misbehaviour.Operator, test.ExpectedAbort)
+		return
+	}
+
+	test.requireValidResults(t, ks, requestID, init, nodes, operatorIDs)
+}
+
+// requireValidResults checks all the operators agree on the validator and the shares reconstruct the validator's key with threshold of them
+func (test *CeremonySpecTest) requireValidResults(
+	t *testing.T,
+	ks *testingutils.TestKeySet,
+	requestID dkg.RequestID,
+	init *dkg.Init,
+	nodes map[types.OperatorID]*dkg.Node,
+	operatorIDs []types.OperatorID,
+) {
+	var expected *dkg.Result
+	secrets := make([]bls.SecretKey, 0, len(operatorIDs))
+	ids := make([]bls.ID, 0, len(operatorIDs))
+	for _, id := range operatorIDs {
+		runner := nodes[id].Runners[requestID]
+		require.Nil(t, runner.Abort)
+		result := nodes[id].GetResult(requestID)
+		require.NotNil(t, result, fmt.Sprintf("operator %d", id))
+		require.Len(t, result.SignedOutputs, len(operatorIDs))
+
+		if expected == nil {
+			expected = result
+		}
+		require.EqualValues(t, id, result.Share.OperatorID)
+		require.EqualValues(t, expected.Share.ValidatorPubKey, result.Share.ValidatorPubKey)
+		require.EqualValues(t, expected.Share.Committee, result.Share.Committee)
+		require.EqualValues(t, expected.DepositData, result.DepositData)
+
+		// the encrypted share is the operator's share secret
+		secretByts, err := types.DecryptShare(ks.DKGOperators[id].EncryptionKey, id, result.Share.ValidatorPubKey[:], result.EncryptedShare)
+		require.NoError(t, err)
+		secret := bls.SecretKey{}
+		require.NoError(t, secret.Deserialize(secretByts))
+		require.EqualValues(t, result.Share.SharePubKey, secret.GetPublicKey().Serialize())
+
+		blsID := bls.ID{}
+		require.NoError(t, blsID.SetDecString(fmt.Sprintf("%d", id)))
+		secrets = append(secrets, secret)
+		ids = append(ids, blsID)
+	}
+
+	// the deposit is signed by the validator
+	signingRoot, _, err := types.GenerateETHDepositData(expected.Share.ValidatorPubKey, init.WithdrawalCredentials, init.Fork, types.DomainDeposit)
+	require.NoError(t, err)
+	validatorPK := bls.PublicKey{}
+	require.NoError(t, validatorPK.Deserialize(expected.Share.ValidatorPubKey))
+	sig := bls.Sign{}
+	require.NoError(t, sig.Deserialize(expected.DepositData.Signature[:]))
+	require.True(t, sig.VerifyByte(&validatorPK, signingRoot))
+
+	// threshold shares reconstruct the validator's key, less don't
+	validatorSK := bls.SecretKey{}
+	require.NoError(t, validatorSK.Recover(secrets[:init.Threshold], ids[:init.Threshold]))
+	require.True(t, validatorSK.GetPublicKey().IsEqual(&validatorPK))
+	require.NoError(t, validatorSK.Recover(secrets[:init.Threshold-1], ids[:init.Threshold-1]))
+	require.False(t, validatorSK.GetPublicKey().IsEqual(&validatorPK))
+}
@@ -0,0 +1,98 @@
+package dealer
+
+import (
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// SplitTestingValidator tests splitting the testing validator's key between 4 operators
+func SplitTestingValidator() *SplitSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &SplitSpecTest{
+		Name:                    "testing validator",
+		OperatorCount:           4,
+		ValidatorSK:             ks.ValidatorSK.Serialize(),
+		ExpectedValidatorPubKey: ks.ValidatorPK.Serialize(),
+	}
+}
+
+// Split7Operators tests splitting a generated key between 7 operators
+func Split7Operators() *SplitSpecTest {
+	return &SplitSpecTest{
+		Name:          "7 operators",
+		OperatorCount: 7,
+	}
+}
+
+// Split10Operators tests splitting a generated key between 10 operators
+func Split10Operators() *SplitSpecTest {
+	return &SplitSpecTest{
+		Name:          "10 operators",
+		OperatorCount: 10,
+	}
+}
+
+// Split13Operators tests splitting a generated key between 13 operators
+func Split13Operators() *SplitSpecTest {
+	return &SplitSpecTest{
+		Name:          "13 operators",
+		OperatorCount: 13,
+	}
+}
+
+// MinThreshold tests splitting with the minimal threshold (f+1)
+func MinThreshold() *SplitSpecTest {
+	return &SplitSpecTest{
+		Name:          "min threshold",
+		OperatorCount: 7,
+		Threshold:     3,
+	}
+}
+
+// WeightedCommittee tests splitting between a weighted committee, the shares' quorums are by weight
+func WeightedCommittee() *SplitSpecTest {
+	return &SplitSpecTest{
+		Name:          "weighted committee",
+		OperatorCount: 4,
+		Weights:       map[types.OperatorID]uint64{1: 2, 2: 2, 3: 2},
+	}
+}
+
+// WeightAboveF tests splitting between a committee whose operator holds more than the max faulty voting power
+func WeightAboveF() *SplitSpecTest {
+	return &SplitSpecTest{
+		Name:          "weight above f",
+		OperatorCount: 4,
+		Weights:       map[types.OperatorID]uint64{1: 2},
+		ExpectedError: "invalid operator 1 share: operator 1 voting power exceeds the max faulty voting power 1",
+	}
+}
+
+// UnsortedOperators tests splitting between operators not given in operator ID order, the shares' committee is sorted
+func UnsortedOperators() *SplitSpecTest {
+	return &SplitSpecTest{
+		Name:          "unsorted operators",
+		OperatorCount: 7,
+		Unsorted:      true,
+	}
+}
+
+// ThresholdTooHigh tests a threshold above the committee's quorum
+func ThresholdTooHigh() *SplitSpecTest {
+	return &SplitSpecTest{
+		Name:          "threshold too high",
+		OperatorCount: 4,
+		Threshold:     4,
+		ExpectedError: "invalid split: invalid threshold",
+	}
+}
+
+// ThresholdAboveMinQuorum tests a threshold some weighted quorum can't reach (weights [2,2,1,1,1,1,1], 4 operators reach quorum 6)
+func ThresholdAboveMinQuorum() *SplitSpecTest {
+	return &SplitSpecTest{
+		Name:          "threshold above min quorum",
+		OperatorCount: 7,
+		Weights:       map[types.OperatorID]uint64{1: 2, 2: 2},
+		ExpectedError: "invalid split: threshold above the committee's minimal quorum",
+	}
+}
@@ -0,0 +1,122 @@
+package dealer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/dkg"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// SplitSpecTest splits a validator key between the key set's operators with a trusted dealer
+type SplitSpecTest struct {
+	Name          string
+	OperatorCount int
+	// Threshold overrides the key set's threshold if not 0
+	Threshold uint64
+	// ValidatorSK is the split validator secret key, generated if nil
+	ValidatorSK []byte
+	// Weights are the operators' weights, 1 if not set
+	Weights map[types.OperatorID]uint64
+	// Unsorted passes the operators in descending operator ID order, the shares' committee is still sorted
+	Unsorted bool `json:",omitempty"`
+	// ExpectedValidatorPubKey is the split validator's public key, not checked if nil
+	ExpectedValidatorPubKey []byte
+	ExpectedError           string
+}
+
+func (test *SplitSpecTest) TestName() string {
+	return "dkg split " + test.Name
+}
+
+func (test *SplitSpecTest) Run(t *testing.T) {
+	types.InitBLS()
+	ks := tests.KeySetForCount(test.OperatorCount)
+	threshold := ks.Threshold
+	if test.Threshold != 0 {
+		threshold = test.Threshold
+	}
+	operators := ks.Committee()
+	for _, operator := range operators {
+		operator.SharePubKey = nil
+		operator.Weight = test.Weights[operator.OperatorID]
+	}
+	var validatorSK *bls.SecretKey
+	if test.ValidatorSK != nil {
+		validatorSK = &bls.SecretKey{}
+		require.NoError(t, validatorSK.Deserialize(test.ValidatorSK))
+	}
+
+	input := operators
+	if test.Unsorted {
+		input = make([]*types.Operator, 0, len(operators))
+		for i := len(operators) - 1; i >= 0; i-- {
+			input = append(input, operators[i])
+		}
+	}
+
+	result, err := dkg.SplitValidatorKey(validatorSK, input, threshold, testingutils.TestingSSVDomainType, testingutils.TestingFeeRecipient)
+	if len(test.ExpectedError) != 0 {
+		require.EqualError(t, err, test.ExpectedError)
+		return
+	}
+	require.NoError(t, err)
+	if test.ExpectedValidatorPubKey != nil {
+		require.EqualValues(t, test.ExpectedValidatorPubKey, result.ValidatorPubKey)
+	}
+	require.Len(t, result.Shares, len(operators))
+
+	// every share is fully populated and its encrypted secret matches its public key
+	msg := []byte("split")
+	partialSigs := make(map[types.OperatorID][]byte)
+	signers := make([]types.OperatorID, 0, len(operators))
+	for i, operator := range operators {
+		dealt := result.Shares[operator.OperatorID]
+		require.NotNil(t, dealt, fmt.Sprintf("operator %d", operator.OperatorID))
+		share := dealt.Share
+		require.EqualValues(t, operator.OperatorID, share.OperatorID)
+		require.EqualValues(t, result.ValidatorPubKey, share.ValidatorPubKey)
+		require.EqualValues(t, result.Committee, share.Committee)
+		require.EqualValues(t, operator.SSVOperatorPubKey, share.Committee[i].SSVOperatorPubKey)
+		require.EqualValues(t, share.SharePubKey, share.Committee[i].SharePubKey)
+		require.EqualValues(t, testingutils.TestingSSVDomainType, share.DomainType)
+		require.EqualValues(t, testingutils.TestingFeeRecipient, share.FeeRecipientAddress)
+		quorum, partialQuorum := types.ComputeQuorum(share.TotalWeight())
+		require.EqualValues(t, quorum, share.Quorum)
+		require.EqualValues(t, partialQuorum, share.PartialQuorum)
+		require.NoError(t, share.Validate())
+
+		byts, err := types.DecryptShare(ks.OperatorKeys[operator.OperatorID], operator.OperatorID, share.ValidatorPubKey[:], dealt.EncryptedShare)
+		require.NoError(t, err)
+		secret := &bls.SecretKey{}
+		require.NoError(t, secret.Deserialize(byts))
+		require.EqualValues(t, share.SharePubKey, secret.GetPublicKey().Serialize())
+
+		partialSigs[operator.OperatorID] = secret.SignByte(msg).Serialize()
+		signers = append(signers, operator.OperatorID)
+	}
+
+	// any threshold of the shares reconstructs the validator's signature, less don't
+	validatorPK := &bls.PublicKey{}
+	require.NoError(t, validatorPK.Deserialize(result.ValidatorPubKey))
+	for _, subset := range [][]types.OperatorID{signers[:threshold], signers[uint64(len(signers))-threshold:]} {
+		sig, err := types.ReconstructSignatures(sigsOf(partialSigs, subset))
+		require.NoError(t, err)
+		require.True(t, sig.VerifyByte(validatorPK, msg))
+	}
+	sig, err := types.ReconstructSignatures(sigsOf(partialSigs, signers[:threshold-1]))
+	require.NoError(t, err)
+	require.False(t, sig.VerifyByte(validatorPK, msg))
+}
+
+func sigsOf(partialSigs map[types.OperatorID][]byte, signers []types.OperatorID) map[types.OperatorID][]byte {
+	ret := make(map[types.OperatorID][]byte)
+	for _, signer := range signers {
+		ret[signer] = partialSigs[signer]
+	}
+	return ret
+}
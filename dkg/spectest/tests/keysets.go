@@ -0,0 +1,17 @@
+package tests
+
+import "github.com/ssvlabs/ssv-spec/types/testingutils"
+
+// KeySetForCount returns the testing key set with the given number of operators, the 4 operators key set if there's none
+func KeySetForCount(count int) *testingutils.TestKeySet {
+	switch count {
+	case 7:
+		return testingutils.Testing7SharesSet()
+	case 10:
+		return testingutils.Testing10SharesSet()
+	case 13:
+		return testingutils.Testing13SharesSet()
+	default:
+		return testingutils.Testing4SharesSet()
+	}
+}
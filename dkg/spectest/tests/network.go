@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/dkg"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// OperatorIDs returns the ceremony's operators' IDs, in order
+func OperatorIDs(init *dkg.Init) []types.OperatorID {
+	ret := make([]types.OperatorID, 0, len(init.Operators))
+	for _, operator := range init.Operators {
+		ret = append(ret, operator.OperatorID)
+	}
+	return ret
+}
+
+// NewNodes returns the participants' nodes, with the keys of the key set returned by keySet, broadcasting to a new in order network
+func NewNodes(participants []types.OperatorID, keySet func(types.OperatorID) *testingutils.TestKeySet) (*Network, map[types.OperatorID]*dkg.Node) {
+	network := &Network{}
+	nodes := make(map[types.OperatorID]*dkg.Node)
+	for _, id := range participants {
+		nodes[id] = dkg.NewNode(id, testingutils.TestingDKGConfig(keySet(id), id, network))
+	}
+	return network, nodes
+}
+
+// TamperF returns the message delivered to the operator in place of the broadcasted one
+type TamperF func(msg *types.SignedSSVMessage, to types.OperatorID) *types.SignedSSVMessage
+
+// Network is an in order network queueing the broadcasted messages, Deliver delivers them to all the participants (including the sender)
+type Network struct {
+	msgs []*types.SignedSSVMessage
+}
+
+func (n *Network) Broadcast(msgID types.MessageID, message *types.SignedSSVMessage) error {
+	n.msgs = append(n.msgs, message)
+	return nil
+}
+
+// Deliver delivers the queued messages, and the messages broadcasted while processing them, to the participants' nodes in order.
+// tamper, if not nil, replaces the message delivered to each participant
+func (n *Network) Deliver(nodes map[types.OperatorID]*dkg.Node, participants []types.OperatorID, tamper TamperF) {
+	for len(n.msgs) > 0 {
+		msg := n.msgs[0]
+		n.msgs = n.msgs[1:]
+		for _, id := range participants {
+			toDeliver := msg
+			if tamper != nil {
+				toDeliver = tamper(msg, id)
+			}
+			// errors are expected once the request aborts, the abort is checked by RequireAbort
+			_ = nodes[id].ProcessMessage(toDeliver)
+		}
+	}
+}
+
+// RequireAbort checks every participant but the culprit aborted the request with the expected abort and has no result
+func RequireAbort(
+	t *testing.T,
+	nodes map[types.OperatorID]*dkg.Node,
+	participants []types.OperatorID,
+	requestID dkg.RequestID,
+	culprit types.OperatorID,
+	expected *dkg.Abort,
+) {
+	for _, id := range participants {
+		if id == culprit {
+			continue
+		}
+		runner := nodes[id].Runners[requestID]
+		require.Nil(t, runner.Result, fmt.Sprintf("operator %d", id))
+		require.EqualValues(t, expected, runner.Abort, fmt.Sprintf("operator %d", id))
+	}
+}
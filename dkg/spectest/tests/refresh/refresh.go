@@ -0,0 +1,80 @@
+package refresh
+
+import (
+	"github.com/ssvlabs/ssv-spec/dkg"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/ceremony"
+)
+
+// Refresh4Operators tests refreshing a 4 operators committee's shares
+func Refresh4Operators() *RefreshSpecTest {
+	return &RefreshSpecTest{
+		Name:          "4 operators",
+		OperatorCount: 4,
+	}
+}
+
+// Refresh7Operators tests refreshing a 7 operators committee's shares
+func Refresh7Operators() *RefreshSpecTest {
+	return &RefreshSpecTest{
+		Name:          "7 operators",
+		OperatorCount: 7,
+	}
+}
+
+// Refresh13Operators tests refreshing a 13 operators committee's shares
+func Refresh13Operators() *RefreshSpecTest {
+	return &RefreshSpecTest{
+		Name:          "13 operators",
+		OperatorCount: 13,
+	}
+}
+
+// WrongOldShare tests an operator starting a refresh with a share not matching the committee
+func WrongOldShare() *RefreshSpecTest {
+	return &RefreshSpecTest{
+		Name:          "wrong old share",
+		OperatorCount: 4,
+		WrongOldShare: 3,
+		ExpectedError: "old share doesn't match committee",
+	}
+}
+
+// NonZeroSecretAbort tests a dealer dealing a non zero secret, which would change the validator's key, all abort
+func NonZeroSecretAbort() *RefreshSpecTest {
+	return &RefreshSpecTest{
+		Name:          "non zero secret abort",
+		OperatorCount: 4,
+		Misbehaviour: &ceremony.Misbehaviour{
+			Operator: 2,
+			Type:     ceremony.WrongSecretCommitment,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 2, Reason: "invalid dealer commitment"},
+	}
+}
+
+// InvalidProofOfPossessionAbort tests a dealer not proving possession of its old share, all abort
+func InvalidProofOfPossessionAbort() *RefreshSpecTest {
+	return &RefreshSpecTest{
+		Name:          "invalid proof of possession abort",
+		OperatorCount: 4,
+		Misbehaviour: &ceremony.Misbehaviour{
+			Operator: 4,
+			Type:     ceremony.InvalidProofOfPossession,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 4, Reason: "invalid proof of possession"},
+	}
+}
+
+// InvalidShareAbort tests a dealer dealing an invalid share, all abort
+func InvalidShareAbort() *RefreshSpecTest {
+	return &RefreshSpecTest{
+		Name:          "invalid share abort",
+		OperatorCount: 7,
+		Misbehaviour: &ceremony.Misbehaviour{
+			Operator: 5,
+			Type:     ceremony.InvalidShare,
+			Victim:   2,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 5, Reason: "invalid share"},
+	}
+}
@@ -0,0 +1,146 @@
+package refresh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/dkg"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/ceremony"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// RefreshSpecTest refreshes the key set's shares of the testing validator over an in order network,
+// delivering every message to all the operators (including the sender).
+type RefreshSpecTest struct {
+	Name          string
+	OperatorCount int
+	Misbehaviour  *ceremony.Misbehaviour
+	// WrongOldShare is an operator starting with a random secret instead of its old share, 0 if none
+	WrongOldShare types.OperatorID
+	// ExpectedError is the error starting the refresh
+	ExpectedError string
+	// ExpectedAbort is the abort of every honest operator, nil if the refresh should finish
+	ExpectedAbort *dkg.Abort
+}
+
+func (test *RefreshSpecTest) TestName() string {
+	return "dkg refresh " + test.Name
+}
+
+func (test *RefreshSpecTest) Run(t *testing.T) {
+	ks := tests.KeySetForCount(test.OperatorCount)
+	requestID := testingutils.TestingDKGRequestID(ks)
+	init := testingutils.TestingDKGInit(ks)
+	init.WithdrawalCredentials = nil
+	init.Refresh = &dkg.Refresh{
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		Committee:       ks.Committee(),
+	}
+
+	keySet := func(types.OperatorID) *testingutils.TestKeySet { return ks }
+	operatorIDs := tests.OperatorIDs(init)
+	network, nodes := tests.NewNodes(operatorIDs, keySet)
+
+	for _, id := range operatorIDs {
+		oldShare := ks.Shares[id]
+		if id == test.WrongOldShare {
+			oldShare = &bls.SecretKey{}
+			oldShare.SetByCSPRNG()
+		}
+		err := nodes[id].StartNewRefresh(requestID, init, oldShare)
+		if len(test.ExpectedError) != 0 && id == test.WrongOldShare {
+			require.EqualError(t, err, test.ExpectedError)
+			return
+		}
+		require.NoError(t, err)
+	}
+
+	network.Deliver(nodes, operatorIDs, test.Misbehaviour.TamperF(keySet, init))
+
+	if test.ExpectedAbort != nil {
+		tests.RequireAbort(t, nodes, operatorIDs, requestID, test.ExpectedAbort.Culprit, test.ExpectedAbort)
+		return
+	}
+
+	test.requireValidResults(t, ks, requestID, init, nodes, operatorIDs)
+}
+
+// requireValidResults checks all the operators agree on the refreshed committee, the new shares reconstruct the validator's signatures
+// and the old shares' signatures don't combine with the new shares' signatures
+func (test *RefreshSpecTest) requireValidResults(
+	t *testing.T,
+	ks *testingutils.TestKeySet,
+	requestID dkg.RequestID,
+	init *dkg.Init,
+	nodes map[types.OperatorID]*dkg.Node,
+	operatorIDs []types.OperatorID,
+) {
+	signingRoot, err := dkg.RequestSigningRoot(requestID, init, testingutils.TestingSSVDomainType)
+	require.NoError(t, err)
+	root := [32]byte{}
+	copy(root[:], signingRoot)
+
+	msg := []byte("refreshing")
+	var expected *dkg.Result
+	newSigs := make(map[types.OperatorID][]byte)
+	oldSigs := make(map[types.OperatorID][]byte)
+	for _, id := range operatorIDs {
+		runner := nodes[id].Runners[requestID]
+		require.Nil(t, runner.Abort)
+		result := nodes[id].GetResult(requestID)
+		require.NotNil(t, result, fmt.Sprintf("operator %d", id))
+		require.Len(t, result.SignedOutputs, len(operatorIDs))
+		require.Nil(t, result.DepositData)
+
+		// the old shares authorized the refresh
+		authorization := &bls.Sign{}
+		require.NoError(t, authorization.Deserialize(result.ReshareAuthorization))
+		require.NoError(t, types.VerifyReconstructedSignature(authorization, ks.ValidatorPK.Serialize(), root))
+
+		if expected == nil {
+			expected = result
+		}
+		require.EqualValues(t, id, result.Share.OperatorID)
+		require.EqualValues(t, ks.ValidatorPK.Serialize(), result.Share.ValidatorPubKey)
+		require.EqualValues(t, expected.Share.Committee, result.Share.Committee)
+		require.NotEqualValues(t, ks.Shares[id].GetPublicKey().Serialize(), result.Share.SharePubKey)
+
+		byts, err := types.DecryptShare(ks.DKGOperators[id].EncryptionKey, id, result.Share.ValidatorPubKey[:], result.EncryptedShare)
+		require.NoError(t, err)
+		secret := &bls.SecretKey{}
+		require.NoError(t, secret.Deserialize(byts))
+		require.EqualValues(t, result.Share.SharePubKey, secret.GetPublicKey().Serialize())
+		newSigs[id] = secret.SignByte(msg).Serialize()
+		oldSigs[id] = ks.Shares[id].SignByte(msg).Serialize()
+	}
+
+	// threshold new shares reconstruct the validator's signature, less don't
+	threshold := int(init.Threshold)
+	sig, err := types.ReconstructSignatures(subset(newSigs, nil, operatorIDs[:threshold]))
+	require.NoError(t, err)
+	require.True(t, sig.VerifyByte(ks.ValidatorPK, msg))
+	sig, err = types.ReconstructSignatures(subset(newSigs, nil, operatorIDs[:threshold-1]))
+	require.NoError(t, err)
+	require.False(t, sig.VerifyByte(ks.ValidatorPK, msg))
+
+	// an old share's signature doesn't combine with the new shares' signatures
+	sig, err = types.ReconstructSignatures(subset(newSigs, oldSigs, operatorIDs[:threshold]))
+	require.NoError(t, err)
+	require.False(t, sig.VerifyByte(ks.ValidatorPK, msg))
+}
+
+// subset returns the signers' new signatures, the first signer's old signature if oldSigs isn't nil
+func subset(newSigs, oldSigs map[types.OperatorID][]byte, signers []types.OperatorID) map[types.OperatorID][]byte {
+	ret := make(map[types.OperatorID][]byte)
+	for _, signer := range signers {
+		ret[signer] = newSigs[signer]
+	}
+	if oldSigs != nil {
+		ret[signers[0]] = oldSigs[signers[0]]
+	}
+	return ret
+}
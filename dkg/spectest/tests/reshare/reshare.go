@@ -0,0 +1,112 @@
+package reshare
+
+import (
+	"github.com/ssvlabs/ssv-spec/dkg"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/ceremony"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// SameCommittee tests a quorum of a 4 operators committee resharing to the same operators
+func SameCommittee() *ReshareSpecTest {
+	return &ReshareSpecTest{
+		Name:             "same committee",
+		OldOperatorCount: 4,
+		NewOperatorCount: 4,
+		Dealers:          []types.OperatorID{1, 2, 3},
+	}
+}
+
+// AllDealers tests all the old committee's operators dealing
+func AllDealers() *ReshareSpecTest {
+	return &ReshareSpecTest{
+		Name:             "all dealers",
+		OldOperatorCount: 4,
+		NewOperatorCount: 4,
+		Dealers:          []types.OperatorID{1, 2, 3, 4},
+	}
+}
+
+// GrowCommittee tests resharing a 4 operators committee to 7 operators
+func GrowCommittee() *ReshareSpecTest {
+	return &ReshareSpecTest{
+		Name:             "grow committee",
+		OldOperatorCount: 4,
+		NewOperatorCount: 7,
+		Dealers:          []types.OperatorID{2, 3, 4},
+	}
+}
+
+// ShrinkCommittee tests resharing a 7 operators committee to 4 operators, dealers 6 and 7 leave the committee
+func ShrinkCommittee() *ReshareSpecTest {
+	return &ReshareSpecTest{
+		Name:             "shrink committee",
+		OldOperatorCount: 7,
+		NewOperatorCount: 4,
+		Dealers:          []types.OperatorID{2, 3, 5, 6, 7},
+	}
+}
+
+// DealersNotQuorum tests less than a quorum of the old committee dealing
+func DealersNotQuorum() *ReshareSpecTest {
+	return &ReshareSpecTest{
+		Name:             "dealers not quorum",
+		OldOperatorCount: 7,
+		NewOperatorCount: 4,
+		Dealers:          []types.OperatorID{1, 2, 3, 4},
+		ExpectedError:    "could not create runner: invalid init: invalid reshare: dealers not a quorum of the old committee",
+	}
+}
+
+// DealerNotInOldCommittee tests a dealer not in the old committee
+func DealerNotInOldCommittee() *ReshareSpecTest {
+	return &ReshareSpecTest{
+		Name:             "dealer not in old committee",
+		OldOperatorCount: 4,
+		NewOperatorCount: 7,
+		Dealers:          []types.OperatorID{1, 2, 5},
+		ExpectedError:    "could not create runner: invalid init: invalid reshare: dealer not in old committee",
+	}
+}
+
+// WrongOldShare tests a dealer dealing a secret other than its old share, all abort
+func WrongOldShare() *ReshareSpecTest {
+	return &ReshareSpecTest{
+		Name:             "wrong old share",
+		OldOperatorCount: 4,
+		NewOperatorCount: 4,
+		Dealers:          []types.OperatorID{1, 2, 3},
+		WrongOldShare:    2,
+		ExpectedAbort:    &dkg.Abort{Culprit: 2, Reason: "invalid dealer commitment"},
+	}
+}
+
+// InvalidShareAbort tests a dealer dealing an invalid share to the new committee, all abort
+func InvalidShareAbort() *ReshareSpecTest {
+	return &ReshareSpecTest{
+		Name:             "invalid share abort",
+		OldOperatorCount: 7,
+		NewOperatorCount: 4,
+		Dealers:          []types.OperatorID{1, 2, 3, 4, 7},
+		Misbehaviour: &ceremony.Misbehaviour{
+			Operator: 7,
+			Type:     ceremony.InvalidShare,
+			Victim:   1,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 7, Reason: "invalid share"},
+	}
+}
+
+// InvalidPartialSignatureAbort tests a new committee operator outputting an invalid partial signature, all abort
+func InvalidPartialSignatureAbort() *ReshareSpecTest {
+	return &ReshareSpecTest{
+		Name:             "invalid partial signature abort",
+		OldOperatorCount: 4,
+		NewOperatorCount: 7,
+		Dealers:          []types.OperatorID{1, 2, 3},
+		Misbehaviour: &ceremony.Misbehaviour{
+			Operator: 6,
+			Type:     ceremony.InvalidDepositSignature,
+		},
+		ExpectedAbort: &dkg.Abort{Culprit: 6, Reason: "invalid partial signature"},
+	}
+}
@@ -0,0 +1,192 @@
+package reshare
+
+import (
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/dkg"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/dkg/spectest/tests/ceremony"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// ReshareSpecTest reshares the testing validator from the old key set's committee to the new key set's committee over an in order network,
+// delivering every message to all the participants (including the sender). The operators of the new committee use the new key set's keys,
+// the old committee's dealers not in the new committee use the old key set's keys.
+type ReshareSpecTest struct {
+	Name             string
+	OldOperatorCount int
+	NewOperatorCount int
+	// Dealers are the old committee's dealers
+	Dealers      []types.OperatorID
+	Misbehaviour *ceremony.Misbehaviour
+	// WrongOldShare is a dealer dealing a random secret instead of its old share, 0 if none
+	WrongOldShare types.OperatorID
+	// ExpectedError is the error starting the reshare
+	ExpectedError string
+	// ExpectedAbort is the abort of every honest participant, nil if the reshare should finish
+	ExpectedAbort *dkg.Abort
+}
+
+func (test *ReshareSpecTest) TestName() string {
+	return "dkg reshare " + test.Name
+}
+
+func (test *ReshareSpecTest) Run(t *testing.T) {
+	oldKS := tests.KeySetForCount(test.OldOperatorCount)
+	newKS := tests.KeySetForCount(test.NewOperatorCount)
+	keySet := func(id types.OperatorID) *testingutils.TestKeySet {
+		if _, found := newKS.DKGOperators[id]; found {
+			return newKS
+		}
+		return oldKS
+	}
+
+	requestID := testingutils.TestingDKGRequestID(newKS)
+	init := testingutils.TestingDKGInit(newKS)
+	init.WithdrawalCredentials = nil
+	init.Reshare = &dkg.Reshare{
+		ValidatorPubKey: oldKS.ValidatorPK.Serialize(),
+		OldCommittee:    oldKS.Committee(),
+		Dealers:         test.Dealers,
+	}
+	for _, operator := range init.Reshare.OldCommittee {
+		ssvOperatorPK, err := x509.MarshalPKIXPublicKey(&keySet(operator.OperatorID).OperatorKeys[operator.OperatorID].PublicKey)
+		require.NoError(t, err)
+		operator.SSVOperatorPubKey = ssvOperatorPK
+	}
+
+	participants := make([]types.OperatorID, 0, len(init.Operators)+len(test.Dealers))
+	for _, operator := range init.Operators {
+		participants = append(participants, operator.OperatorID)
+	}
+	for _, dealer := range test.Dealers {
+		if init.Operator(dealer) == nil {
+			participants = append(participants, dealer)
+		}
+	}
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i] < participants[j]
+	})
+
+	network, nodes := tests.NewNodes(participants, keySet)
+	for _, id := range participants {
+		var oldShare *bls.SecretKey
+		if init.IsDealer(id) {
+			oldShare = oldKS.Shares[id]
+			if id == test.WrongOldShare {
+				oldShare = &bls.SecretKey{}
+				oldShare.SetByCSPRNG()
+			}
+		}
+		err := nodes[id].StartNewReshare(requestID, init, oldShare)
+		if len(test.ExpectedError) != 0 {
+			require.EqualError(t, err, test.ExpectedError)
+			return
+		}
+		require.NoError(t, err)
+	}
+
+	network.Deliver(nodes, participants, test.Misbehaviour.TamperF(keySet, init))
+
+	if test.ExpectedAbort != nil {
+		tests.RequireAbort(t, nodes, participants, requestID, test.ExpectedAbort.Culprit, test.ExpectedAbort)
+		return
+	}
+
+	test.requireValidResults(t, oldKS, newKS, requestID, init, nodes, participants)
+}
+
+// requireValidResults checks all the participants agree on the new committee, the old committee's dealers authorized the reshare
+// and the new committee's shares reconstruct the validator's signatures
+func (test *ReshareSpecTest) requireValidResults(
+	t *testing.T,
+	oldKS *testingutils.TestKeySet,
+	newKS *testingutils.TestKeySet,
+	requestID dkg.RequestID,
+	init *dkg.Init,
+	nodes map[types.OperatorID]*dkg.Node,
+	participants []types.OperatorID,
+) {
+	signingRoot, err := dkg.RequestSigningRoot(requestID, init, testingutils.TestingSSVDomainType)
+	require.NoError(t, err)
+	root := [32]byte{}
+	copy(root[:], signingRoot)
+
+	var expected *dkg.Result
+	for _, id := range participants {
+		runner := nodes[id].Runners[requestID]
+		require.Nil(t, runner.Abort)
+		result := nodes[id].GetResult(requestID)
+		require.NotNil(t, result, fmt.Sprintf("operator %d", id))
+		require.Len(t, result.SignedOutputs, len(init.Operators))
+		require.Nil(t, result.DepositData)
+
+		// the old committee's dealers authorized the reshare
+		authorization := &bls.Sign{}
+		require.NoError(t, authorization.Deserialize(result.ReshareAuthorization))
+		require.NoError(t, types.VerifyReconstructedSignature(authorization, oldKS.ValidatorPK.Serialize(), root))
+
+		// a dealer not in the new committee has no share
+		if init.Operator(id) == nil {
+			require.Nil(t, result.Share, fmt.Sprintf("operator %d", id))
+			continue
+		}
+		if expected == nil {
+			expected = result
+		}
+		require.EqualValues(t, id, result.Share.OperatorID)
+		require.EqualValues(t, oldKS.ValidatorPK.Serialize(), result.Share.ValidatorPubKey)
+		require.EqualValues(t, expected.Share.Committee, result.Share.Committee)
+	}
+
+	// any threshold of the new committee's shares reconstructs the validator's signature, less don't
+	msg := []byte("resharing")
+	partialSigs := make(map[types.OperatorID][]byte)
+	for _, operator := range init.Operators {
+		result := nodes[operator.OperatorID].GetResult(requestID)
+		byts, err := types.DecryptShare(newKS.DKGOperators[operator.OperatorID].EncryptionKey, operator.OperatorID, result.Share.ValidatorPubKey[:], result.EncryptedShare)
+		require.NoError(t, err)
+		secret := &bls.SecretKey{}
+		require.NoError(t, secret.Deserialize(byts))
+		require.EqualValues(t, expected.Share.Committee[operator.OperatorID-1].SharePubKey, secret.GetPublicKey().Serialize())
+		partialSigs[operator.OperatorID] = secret.SignByte(msg).Serialize()
+	}
+	for _, signers := range [][]types.OperatorID{firstSigners(init, init.Threshold), lastSigners(init, init.Threshold)} {
+		sig, err := types.ReconstructSignatures(subset(partialSigs, signers))
+		require.NoError(t, err)
+		require.True(t, sig.VerifyByte(oldKS.ValidatorPK, msg))
+	}
+	sig, err := types.ReconstructSignatures(subset(partialSigs, firstSigners(init, init.Threshold-1)))
+	require.NoError(t, err)
+	require.False(t, sig.VerifyByte(oldKS.ValidatorPK, msg))
+}
+
+func firstSigners(init *dkg.Init, count uint64) []types.OperatorID {
+	ret := make([]types.OperatorID, 0, count)
+	for _, operator := range init.Operators[:count] {
+		ret = append(ret, operator.OperatorID)
+	}
+	return ret
+}
+
+func lastSigners(init *dkg.Init, count uint64) []types.OperatorID {
+	ret := make([]types.OperatorID, 0, count)
+	for _, operator := range init.Operators[uint64(len(init.Operators))-count:] {
+		ret = append(ret, operator.OperatorID)
+	}
+	return ret
+}
+
+func subset(partialSigs map[types.OperatorID][]byte, signers []types.OperatorID) map[types.OperatorID][]byte {
+	ret := make(map[types.OperatorID][]byte)
+	for _, signer := range signers {
+		ret[signer] = partialSigs[signer]
+	}
+	return ret
+}
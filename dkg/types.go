@@ -0,0 +1,313 @@
+package dkg
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/p2p"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// RequestID identifies a DKG ceremony, the requester's eth address followed by the request's index (little endian)
+type RequestID [24]byte
+
+func NewRequestID(requester common.Address, index uint32) RequestID {
+	ret := RequestID{}
+	copy(ret[:20], requester[:])
+	binary.LittleEndian.PutUint32(ret[20:], index)
+	return ret
+}
+
+// MessageID returns the message ID of the ceremony's messages, the request ID takes the place of the validator public key (the role is empty)
+func (id RequestID) MessageID(domain types.DomainType) types.MessageID {
+	ret := types.MessageID{}
+	copy(ret[:len(domain)], domain[:])
+	copy(ret[len(domain):], id[:])
+	return ret
+}
+
+// Network is the network the DKG messages are broadcasted to
+type Network interface {
+	p2p.Broadcaster
+}
+
+// Operator is a DKG participant
+type Operator struct {
+	OperatorID types.OperatorID
+	// ETHAddress signs the DKG output
+	ETHAddress common.Address
+	// EncryptionPubKey is the PKIX encoded RSA public key the operator's shares are encrypted to
+	EncryptionPubKey []byte
+	// SSVOperatorPubKey is the public key signing the operator's SignedSSVMessages, encoded according to SSVOperatorKeyType
+	SSVOperatorPubKey []byte
+	// SSVOperatorKeyType is the type of the operator's SSV key, RSA if not set
+	SSVOperatorKeyType types.OperatorKeyType `json:",omitempty"`
+}
+
+// Init is the DKG ceremony request, sent by the requester to all the participating operators
+type Init struct {
+	Operators []*Operator
+	// Threshold is the number of shares needed to reconstruct a signature
+	Threshold             uint64
+	WithdrawalCredentials []byte
+	Fork                  phase0.Version
+	// Owner is the validator's owner, set as the share's fee recipient
+	Owner common.Address
+	Nonce uint64
+	// Reshare moves an existing validator to the operators, nil for a new validator
+	Reshare *Reshare `json:",omitempty"`
+	// Refresh rerandomizes the operators' shares of an existing validator, nil for a new validator
+	Refresh *Refresh `json:",omitempty"`
+}
+
+// Reshare moves an existing validator to a new committee (Init.Operators) without reconstructing the validator's key.
+// A quorum of the old committee (the dealers) deals its shares, the new committee's shares are their lagrange interpolation.
+type Reshare struct {
+	ValidatorPubKey []byte
+	// OldCommittee is the validator's current committee (Share.Committee)
+	OldCommittee []*types.Operator
+	// Dealers are the old committee operators dealing their shares
+	Dealers []types.OperatorID
+}
+
+// Validate returns error if the reshare is invalid
+func (reshare *Reshare) Validate() error {
+	if len(reshare.ValidatorPubKey) != 48 {
+		return errors.New("invalid validator public key")
+	}
+	if !validCommitteeSize(len(reshare.OldCommittee)) {
+		return errors.New("invalid old committee size")
+	}
+	quorum, _ := types.ComputeQuorum(uint64(len(reshare.OldCommittee)))
+	if uint64(len(reshare.Dealers)) < quorum {
+		return errors.New("dealers not a quorum of the old committee")
+	}
+	dealers := make(map[types.OperatorID]bool)
+	for _, dealer := range reshare.Dealers {
+		if dealers[dealer] {
+			return errors.New("duplicate dealer")
+		}
+		dealers[dealer] = true
+		if reshare.OldOperator(dealer) == nil {
+			return errors.New("dealer not in old committee")
+		}
+	}
+	return nil
+}
+
+// OldOperator returns the old committee's operator, nil if not found
+func (reshare *Reshare) OldOperator(operatorID types.OperatorID) *types.Operator {
+	for _, operator := range reshare.OldCommittee {
+		if operator.OperatorID == operatorID {
+			return operator
+		}
+	}
+	return nil
+}
+
+// Refresh rerandomizes the shares of an existing validator's committee (Init.Operators) without changing the validator's key,
+// limiting the use of shares leaked over time. Every operator deals a random polynomial with a zero secret,
+// its new share is its old share plus the evaluations dealt to it.
+type Refresh struct {
+	ValidatorPubKey []byte
+	// Committee is the validator's current committee (Share.Committee), the same operators as Init.Operators
+	Committee []*types.Operator
+}
+
+// Validate returns error if the refresh is invalid for the operators
+func (refresh *Refresh) Validate(operators []*Operator) error {
+	if len(refresh.ValidatorPubKey) != 48 {
+		return errors.New("invalid validator public key")
+	}
+	if len(refresh.Committee) != len(operators) {
+		return errors.New("committee doesn't match operators")
+	}
+	for _, operator := range operators {
+		committeeOperator := refresh.CommitteeOperator(operator.OperatorID)
+		if committeeOperator == nil {
+			return errors.New("committee doesn't match operators")
+		}
+		if len(committeeOperator.SharePubKey) != 48 {
+			return errors.New("invalid share public key")
+		}
+	}
+	return nil
+}
+
+// CommitteeOperator returns the committee's operator, nil if not found
+func (refresh *Refresh) CommitteeOperator(operatorID types.OperatorID) *types.Operator {
+	for _, operator := range refresh.Committee {
+		if operator.OperatorID == operatorID {
+			return operator
+		}
+	}
+	return nil
+}
+
+// Validate returns error if the request is invalid
+func (init *Init) Validate() error {
+	operatorIDs := make([]types.OperatorID, 0, len(init.Operators))
+	for _, operator := range init.Operators {
+		operatorIDs = append(operatorIDs, operator.OperatorID)
+	}
+	if err := validateCommittee(operatorIDs, init.Threshold); err != nil {
+		return err
+	}
+	if init.Reshare != nil && init.Refresh != nil {
+		return errors.New("both reshare and refresh")
+	}
+	if init.Reshare != nil {
+		return errors.Wrap(init.Reshare.Validate(), "invalid reshare")
+	}
+	if init.Refresh != nil {
+		return errors.Wrap(init.Refresh.Validate(init.Operators), "invalid refresh")
+	}
+	if len(init.WithdrawalCredentials) != 32 {
+		return errors.New("invalid withdrawal credentials")
+	}
+	return nil
+}
+
+// Dealers returns the operators dealing a polynomial, all the operators for a new validator or a refresh
+func (init *Init) Dealers() []types.OperatorID {
+	if init.Reshare != nil {
+		return init.Reshare.Dealers
+	}
+	ret := make([]types.OperatorID, 0, len(init.Operators))
+	for _, operator := range init.Operators {
+		ret = append(ret, operator.OperatorID)
+	}
+	return ret
+}
+
+// IsDealer returns true if the operator deals a polynomial
+func (init *Init) IsDealer(operatorID types.OperatorID) bool {
+	for _, dealer := range init.Dealers() {
+		if dealer == operatorID {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatorPubKey returns the existing validator's public key for a reshare or refresh, nil for a new validator (not known before the ceremony)
+func (init *Init) ValidatorPubKey() []byte {
+	switch {
+	case init.Reshare != nil:
+		return init.Reshare.ValidatorPubKey
+	case init.Refresh != nil:
+		return init.Refresh.ValidatorPubKey
+	default:
+		return nil
+	}
+}
+
+// GetRoot returns the request's root
+func (init *Init) GetRoot() ([32]byte, error) {
+	byts, err := init.Encode()
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not encode init")
+	}
+	return sha256.Sum256(byts), nil
+}
+
+// Operator returns the participating operator, nil if not found
+func (init *Init) Operator(operatorID types.OperatorID) *Operator {
+	for _, operator := range init.Operators {
+		if operator.OperatorID == operatorID {
+			return operator
+		}
+	}
+	return nil
+}
+
+// SSVOperators returns the participating operators (including a reshare's dealers) for SignedSSVMessage verification
+func (init *Init) SSVOperators() []*types.Operator {
+	ret := make([]*types.Operator, 0, len(init.Operators))
+	for _, operator := range init.Operators {
+		ret = append(ret, &types.Operator{
+			OperatorID:         operator.OperatorID,
+			SSVOperatorPubKey:  operator.SSVOperatorPubKey,
+			SSVOperatorKeyType: operator.SSVOperatorKeyType,
+		})
+	}
+	if init.Reshare != nil {
+		for _, dealer := range init.Reshare.Dealers {
+			if init.Operator(dealer) != nil {
+				continue
+			}
+			operator := init.Reshare.OldOperator(dealer)
+			ret = append(ret, &types.Operator{
+				OperatorID:         operator.OperatorID,
+				SSVOperatorPubKey:  operator.SSVOperatorPubKey,
+				SSVOperatorKeyType: operator.SSVOperatorKeyType,
+			})
+		}
+	}
+	return ret
+}
+
+// IsParticipant returns true if the operator is in the (new) committee or a dealer
+func (init *Init) IsParticipant(operatorID types.OperatorID) bool {
+	return init.Operator(operatorID) != nil || init.IsDealer(operatorID)
+}
+
+// Encode returns the encoded struct in bytes or error
+func (init *Init) Encode() ([]byte, error) {
+	return json.Marshal(init)
+}
+
+// Decode returns error if decoding failed
+func (init *Init) Decode(data []byte) error {
+	return json.Unmarshal(data, init)
+}
+
+// validateCommittee returns error if the operators can't share a validator key with the threshold
+func validateCommittee(operatorIDs []types.OperatorID, threshold uint64) error {
+	if !validCommitteeSize(len(operatorIDs)) {
+		return errors.New("invalid number of operators")
+	}
+	ids := make(map[types.OperatorID]bool)
+	for _, id := range operatorIDs {
+		if id == 0 {
+			return errors.New("invalid operator id")
+		}
+		if ids[id] {
+			return errors.New("duplicate operator")
+		}
+		ids[id] = true
+	}
+
+	// any quorum must be able to reconstruct a signature, no f operators can
+	quorum, partialQuorum := types.ComputeQuorum(uint64(len(operatorIDs)))
+	if threshold < partialQuorum || threshold > quorum {
+		return errors.New("invalid threshold")
+	}
+	return nil
+}
+
+func validCommitteeSize(size int) bool {
+	switch size {
+	case 4, 7, 10, 13:
+		return true
+	default:
+		return false
+	}
+}
+
+// Config holds the operator's DKG dependencies
+type Config struct {
+	// Signer signs the DKG output with the operator's eth address
+	Signer            types.DKGSigner
+	OperatorSigner    types.OperatorSigner
+	SignatureVerifier types.SignatureVerifier
+	Network           Network
+	Domain            types.DomainType
+	// EncryptionKey decrypts the shares dealt to the operator
+	EncryptionKey *rsa.PrivateKey
+}
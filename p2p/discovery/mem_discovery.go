@@ -0,0 +1,73 @@
+package discovery
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+)
+
+// Candidate is a discovered peer that shares subnets with the local node
+type Candidate struct {
+	PeerID  string
+	Subnets Subnets
+	Score   float64
+}
+
+// MemDiscovery is an in memory stand-in for discv5, it holds the node records of peers and finds candidates by their subnets entry
+type MemDiscovery struct {
+	SubnetsCount uint64
+	records      map[string]*enr.Record
+}
+
+func NewMemDiscovery(subnetsCount uint64) *MemDiscovery {
+	return &MemDiscovery{
+		SubnetsCount: subnetsCount,
+		records:      map[string]*enr.Record{},
+	}
+}
+
+// Advertise stores (or replaces) the node record of the peer
+func (d *MemDiscovery) Advertise(peerID string, record *enr.Record) {
+	d.records[peerID] = record
+}
+
+// FindPeers returns up to limit candidates sharing subnets with the local node and scoring above minScore, sorted by score (descending) and peer id.
+// Peers in connected are skipped, records without a valid subnets entry are ignored.
+func (d *MemDiscovery) FindPeers(local Subnets, connected map[string]Subnets, minScore float64, limit int) []*Candidate {
+	connectedSubnets := make([]Subnets, 0, len(connected))
+	for _, subnets := range connected {
+		connectedSubnets = append(connectedSubnets, subnets)
+	}
+	subnetScores := SubnetScores(local, PeersPerSubnet(d.SubnetsCount, connectedSubnets))
+
+	ret := make([]*Candidate, 0)
+	for peerID, record := range d.records {
+		if _, found := connected[peerID]; found {
+			continue
+		}
+		subnets, err := GetSubnetsEntry(record, d.SubnetsCount)
+		if err != nil || len(local.Shared(subnets)) == 0 {
+			continue
+		}
+		score := ScorePeer(local, subnets, subnetScores)
+		if score <= minScore {
+			continue
+		}
+		ret = append(ret, &Candidate{
+			PeerID:  peerID,
+			Subnets: subnets,
+			Score:   score,
+		})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Score != ret[j].Score {
+			return ret[i].Score > ret[j].Score
+		}
+		return ret[i].PeerID < ret[j].PeerID
+	})
+	if len(ret) > limit {
+		ret = ret[:limit]
+	}
+	return ret
+}
@@ -0,0 +1,60 @@
+package discovery
+
+const (
+	// MinPeersPerSubnet is the number of peers up to which a subnet is considered to be lacking peers
+	MinPeersPerSubnet = 2
+	// MaxPeersPerSubnet is the number of peers from which a subnet is considered to have an overflow of peers
+	MaxPeersPerSubnet = 5
+
+	// subnet scores, see "Peers Balancing" in SPEC.md
+	noPeersSubnetScore      = 2
+	lackingPeersSubnetScore = 1
+	overflowSubnetScore     = -1
+	// sharedSubnetBonus is added for every shared subnet, preferring peers with multiple shared subnets
+	sharedSubnetBonus = 0.25
+)
+
+// PeersPerSubnet returns the number of peers in each subnet
+func PeersPerSubnet(subnetsCount uint64, peers []Subnets) []int {
+	ret := make([]int, subnetsCount)
+	for _, peer := range peers {
+		for _, subnet := range peer.Active() {
+			if subnet < subnetsCount {
+				ret[subnet]++
+			}
+		}
+	}
+	return ret
+}
+
+// SubnetScores returns the score of each subnet the local node needs according to the number of peers it has in the subnet,
+// subnets lacking peers get a positive score and subnets with an overflow of peers get a negative score
+func SubnetScores(local Subnets, peersPerSubnet []int) []float64 {
+	ret := make([]float64, len(peersPerSubnet))
+	for _, subnet := range local.Active() {
+		if subnet >= uint64(len(peersPerSubnet)) {
+			continue
+		}
+		switch count := peersPerSubnet[subnet]; {
+		case count == 0:
+			ret[subnet] = noPeersSubnetScore
+		case count <= MinPeersPerSubnet:
+			ret[subnet] = lackingPeersSubnetScore
+		case count >= MaxPeersPerSubnet:
+			ret[subnet] = overflowSubnetScore
+		}
+	}
+	return ret
+}
+
+// ScorePeer returns the score of a peer according to the subnets it shares with the local node
+func ScorePeer(local, peer Subnets, subnetScores []float64) float64 {
+	score := float64(0)
+	for _, subnet := range local.Shared(peer) {
+		if subnet < uint64(len(subnetScores)) {
+			score += subnetScores[subnet]
+		}
+		score += sharedSubnetBonus
+	}
+	return score
+}
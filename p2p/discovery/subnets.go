@@ -0,0 +1,110 @@
+package discovery
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/pkg/errors"
+)
+
+// SubnetsENRKey is the key of the subnets bitfield ENR entry
+const SubnetsENRKey = "subnets"
+
+// Subnets is a bitfield of the subnets a node is interested in, subnet i is bit i%8 (least significant first) of byte i/8.
+// It's advertised in the node's ENR under SubnetsENRKey and in the handshake metadata as an hex string.
+type Subnets []byte
+
+// NewSubnets returns an empty bitfield for the given number of subnets
+func NewSubnets(subnetsCount uint64) Subnets {
+	return make(Subnets, subnetsSize(subnetsCount))
+}
+
+// SubnetsFromHex decodes an hex bitfield of the given number of subnets
+func SubnetsFromHex(s string, subnetsCount uint64) (Subnets, error) {
+	byts, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode subnets")
+	}
+	ret := Subnets(byts)
+	if err := ret.validate(subnetsCount); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func subnetsSize(subnetsCount uint64) int {
+	return int((subnetsCount + 7) / 8)
+}
+
+func (s Subnets) validate(subnetsCount uint64) error {
+	if len(s) != subnetsSize(subnetsCount) {
+		return errors.New("wrong subnets bitfield size")
+	}
+	return nil
+}
+
+// ENRKey implements enr.Entry
+func (s Subnets) ENRKey() string {
+	return SubnetsENRKey
+}
+
+// Set marks the subnet, returns error if the subnet is out of the bitfield's range
+func (s Subnets) Set(subnet uint64) error {
+	if subnet/8 >= uint64(len(s)) {
+		return errors.Errorf("subnet %d out of range", subnet)
+	}
+	s[subnet/8] |= 1 << (subnet % 8)
+	return nil
+}
+
+// Has returns true if the subnet is marked
+func (s Subnets) Has(subnet uint64) bool {
+	if subnet/8 >= uint64(len(s)) {
+		return false
+	}
+	return s[subnet/8]&(1<<(subnet%8)) != 0
+}
+
+// Active returns the marked subnets in ascending order
+func (s Subnets) Active() []uint64 {
+	ret := make([]uint64, 0)
+	for subnet := uint64(0); subnet < uint64(len(s))*8; subnet++ {
+		if s.Has(subnet) {
+			ret = append(ret, subnet)
+		}
+	}
+	return ret
+}
+
+// Shared returns the subnets marked in both bitfields in ascending order
+func (s Subnets) Shared(other Subnets) []uint64 {
+	ret := make([]uint64, 0)
+	for _, subnet := range s.Active() {
+		if other.Has(subnet) {
+			ret = append(ret, subnet)
+		}
+	}
+	return ret
+}
+
+// String returns the hex encoding of the bitfield
+func (s Subnets) String() string {
+	return hex.EncodeToString(s)
+}
+
+// SetSubnetsEntry sets the subnets entry of the node record
+func SetSubnetsEntry(record *enr.Record, subnets Subnets) {
+	record.Set(subnets)
+}
+
+// GetSubnetsEntry returns the subnets entry of the node record, returns error if missing or not of the given number of subnets
+func GetSubnetsEntry(record *enr.Record, subnetsCount uint64) (Subnets, error) {
+	ret := Subnets{}
+	if err := record.Load(&ret); err != nil {
+		return nil, errors.Wrap(err, "could not load subnets entry")
+	}
+	if err := ret.validate(subnetsCount); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,164 @@
+package handshake
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// ProtocolID is the libp2p protocol of the handshake stream
+const ProtocolID = "/ssv/info/0.0.1"
+
+// MaxSignedNodeInfoSize is the max size of an encoded SignedNodeInfo on the wire
+const MaxSignedNodeInfoSize = 4096
+
+// SignatureDomain prefixes the encoded NodeInfo before it's signed with the operator's network key, so a NodeInfo signature
+// is never a valid SignedSSVMessage signature over the same bytes (and vice versa)
+var SignatureDomain = []byte("ssv/handshake/node-info")
+
+// signingData returns the data signed for the encoded NodeInfo
+func signingData(data []byte) []byte {
+	return append(append(make([]byte, 0, len(SignatureDomain)+len(data)), SignatureDomain...), data...)
+}
+
+// OperatorGetterF returns the operator with the given ID, nil if the operator is unknown
+type OperatorGetterF func(operatorID types.OperatorID) *types.Operator
+
+// Handshaker signs the local node's info and verifies the info of remote peers.
+// Peers on another network or fork, with an unknown operator or an invalid signature are rejected.
+type Handshaker struct {
+	NodeInfo          *NodeInfo
+	Signer            types.OperatorSigner
+	SignatureVerifier types.SignatureVerifier
+	GetOperator       OperatorGetterF
+}
+
+func NewHandshaker(
+	nodeInfo *NodeInfo,
+	signer types.OperatorSigner,
+	signatureVerifier types.SignatureVerifier,
+	getOperator OperatorGetterF,
+) *Handshaker {
+	return &Handshaker{
+		NodeInfo:          nodeInfo,
+		Signer:            signer,
+		SignatureVerifier: signatureVerifier,
+		GetOperator:       getOperator,
+	}
+}
+
+// SignNodeInfo returns the local node info signed with the operator's network key (over SignatureDomain || encoded node info)
+func (h *Handshaker) SignNodeInfo() (*SignedNodeInfo, error) {
+	if h.NodeInfo.Metadata == nil {
+		return nil, errors.New("node info has no metadata")
+	}
+	data, err := h.NodeInfo.Encode()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode node info")
+	}
+	sig, err := h.Signer.SignSSVMessage(signingData(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign node info")
+	}
+	return &SignedNodeInfo{
+		Signature:  sig,
+		OperatorID: h.NodeInfo.Metadata.OperatorID,
+		Data:       data,
+	}, nil
+}
+
+// VerifyNodeInfo returns the remote peer's node info if it's valid for the local node
+func (h *Handshaker) VerifyNodeInfo(signed *SignedNodeInfo, remotePeer string) (*NodeInfo, error) {
+	info, err := signed.GetNodeInfo()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode node info")
+	}
+	if info.NetworkID != h.NodeInfo.NetworkID {
+		return nil, errors.New("wrong network id")
+	}
+	if info.Domain != h.NodeInfo.Domain {
+		return nil, errors.New("wrong domain")
+	}
+	if info.PeerID != remotePeer {
+		return nil, errors.New("peer id doesn't match remote peer")
+	}
+	if info.Metadata == nil || info.Metadata.OperatorID != signed.OperatorID {
+		return nil, errors.New("signer doesn't match node operator")
+	}
+
+	operator := h.GetOperator(signed.OperatorID)
+	if operator == nil {
+		return nil, errors.New("unknown operator")
+	}
+	msg := &types.SignedSSVMessage{
+		Signature:  signed.Signature,
+		OperatorID: signed.OperatorID,
+		Data:       signingData(signed.Data),
+	}
+	if err := h.SignatureVerifier.Verify(msg, []*types.Operator{operator}); err != nil {
+		return nil, errors.Wrap(err, "invalid node info signature")
+	}
+	return info, nil
+}
+
+// Handshake exchanges signed node infos with the remote peer over the given stream and returns the remote node info once verified.
+// Each node info is sent as a 4 bytes big endian length followed by the encoded SignedNodeInfo.
+// If the remote node info can't be read the stream is closed (if it's an io.Closer), unblocking the pending write.
+func (h *Handshaker) Handshake(stream io.ReadWriter, remotePeer string) (*NodeInfo, error) {
+	signed, err := h.SignNodeInfo()
+	if err != nil {
+		return nil, err
+	}
+	data, err := signed.Encode()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode signed node info")
+	}
+
+	// write concurrently as both peers send their info before reading
+	writeErr := make(chan error, 1)
+	go func() {
+		writeErr <- writeSignedNodeInfo(stream, data)
+	}()
+
+	remote, err := readSignedNodeInfo(stream)
+	if err != nil {
+		if closer, ok := stream.(io.Closer); ok {
+			_ = closer.Close()
+		}
+		return nil, err
+	}
+	if err := <-writeErr; err != nil {
+		return nil, errors.Wrap(err, "could not send node info")
+	}
+	return h.VerifyNodeInfo(remote, remotePeer)
+}
+
+func writeSignedNodeInfo(w io.Writer, data []byte) error {
+	if len(data) > MaxSignedNodeInfoSize {
+		return errors.New("signed node info is too big")
+	}
+	byts := binary.BigEndian.AppendUint32(make([]byte, 0, 4+len(data)), uint32(len(data)))
+	_, err := w.Write(append(byts, data...))
+	return err
+}
+
+func readSignedNodeInfo(r io.Reader) (*SignedNodeInfo, error) {
+	size := make([]byte, 4)
+	if _, err := io.ReadFull(r, size); err != nil {
+		return nil, errors.Wrap(err, "could not read node info size")
+	}
+	if binary.BigEndian.Uint32(size) > MaxSignedNodeInfoSize {
+		return nil, errors.New("signed node info is too big")
+	}
+	data := make([]byte, binary.BigEndian.Uint32(size))
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, errors.Wrap(err, "could not read node info")
+	}
+	ret := &SignedNodeInfo{}
+	if err := ret.Decode(data); err != nil {
+		return nil, errors.Wrap(err, "could not decode signed node info")
+	}
+	return ret, nil
+}
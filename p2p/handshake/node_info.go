@@ -0,0 +1,69 @@
+package handshake
+
+import (
+	"encoding/json"
+
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// NodeMetadata holds node's general information
+type NodeMetadata struct {
+	// OperatorID of the node
+	OperatorID types.OperatorID
+	// Subnets is an hex string that represents subnets with interest
+	Subnets string
+	// NodeVersion is the current ssv-node version
+	NodeVersion string
+	// ExecutionNode is the eth1 node used by the node
+	ExecutionNode string
+	// ConsensusNode is the eth2 node used by the node
+	ConsensusNode string
+}
+
+// NodeInfo contains node's information, exchanged and verified on every new connection
+type NodeInfo struct {
+	// NetworkID is the id of the node's network
+	NetworkID types.NetworkID
+	// Domain is the domain type of the current fork used by the node
+	Domain types.DomainType
+	// PeerID is the libp2p peer id of the node, binds the signed info to the connection it was sent on
+	PeerID string
+	// Metadata of the node
+	Metadata *NodeMetadata
+}
+
+// Encode returns a msg encoded bytes or error
+func (ni *NodeInfo) Encode() ([]byte, error) {
+	return json.Marshal(ni)
+}
+
+// Decode returns error if decoding failed
+func (ni *NodeInfo) Decode(data []byte) error {
+	return json.Unmarshal(data, ni)
+}
+
+// SignedNodeInfo is an encoded NodeInfo signed by the operator's network key
+type SignedNodeInfo struct {
+	Signature  []byte
+	OperatorID types.OperatorID
+	Data       []byte
+}
+
+// Encode returns a msg encoded bytes or error
+func (sni *SignedNodeInfo) Encode() ([]byte, error) {
+	return json.Marshal(sni)
+}
+
+// Decode returns error if decoding failed
+func (sni *SignedNodeInfo) Decode(data []byte) error {
+	return json.Unmarshal(data, sni)
+}
+
+// GetNodeInfo returns the decoded NodeInfo
+func (sni *SignedNodeInfo) GetNodeInfo() (*NodeInfo, error) {
+	ret := &NodeInfo{}
+	if err := ret.Decode(sni.Data); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
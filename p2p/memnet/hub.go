@@ -0,0 +1,298 @@
+package memnet
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/p2p"
+	"github.com/ssvlabs/ssv-spec/p2p/validation"
+	"github.com/ssvlabs/ssv-spec/p2p/wire"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// MsgHandlerF handles a message delivered to a node, e.g. ssv.Validator.ProcessMessage
+type MsgHandlerF func(msg *types.SignedSSVMessage) error
+
+// Delivery is a record of a message delivered (or dropped by validation) to a node
+type Delivery struct {
+	From types.OperatorID
+	To   types.OperatorID
+	// At is the virtual time passed since the hub started
+	At time.Duration
+	// Data is the wire encoded message as received by the node
+	Data []byte
+	// Message is the decoded message, nil if not accepted by validation
+	Message *types.SignedSSVMessage
+	// Result is the validation result of the receiving node, the message is handled only if accepted
+	Result pubsub.ValidationResult
+	// HandlerErr is the error returned by the receiving node's handler
+	HandlerErr error
+}
+
+// Hub is a deterministic in process network of simulated operators.
+// Broadcasted messages are routed by their MessageID to every node subscribed to the message's validator,
+// validated by the receiving node's validator and handled by its handler.
+// Deliveries are scheduled in virtual time, with configurable delays, partitions and a random jitter drawn from the hub's seed,
+// the same seed and the same sequence of calls always results in the same deliveries.
+// The hub is not safe for concurrent use, handlers run synchronously within Step and may broadcast.
+type Hub struct {
+	startTime time.Time
+	now       time.Duration
+	rand      *rand.Rand
+	maxJitter time.Duration
+	// WireVersion is the wire version the nodes encode their messages with, wire.SnappyVersion by default
+	WireVersion wire.Version
+
+	nodes      map[types.OperatorID]*Node
+	delays     map[[2]types.OperatorID]time.Duration
+	partitions map[types.OperatorID]int
+
+	queue      pendingQueue
+	seq        uint64
+	deliveries []*Delivery
+}
+
+func NewHub(seed int64, startTime time.Time) *Hub {
+	return &Hub{
+		startTime:   startTime,
+		WireVersion: wire.SnappyVersion,
+		rand:        rand.New(rand.NewSource(seed)),
+		nodes:       map[types.OperatorID]*Node{},
+		delays:      map[[2]types.OperatorID]time.Duration{},
+		partitions:  map[types.OperatorID]int{},
+	}
+}
+
+// AddNode adds a simulated operator to the network
+func (h *Hub) AddNode(operatorID types.OperatorID) *Node {
+	node := &Node{
+		OperatorID:    operatorID,
+		PeerID:        peer.ID(fmt.Sprintf("operator-%d", operatorID)),
+		hub:           h,
+		subscriptions: map[string]bool{},
+	}
+	h.nodes[operatorID] = node
+	return node
+}
+
+// Node returns the node of the operator, nil if not found
+func (h *Hub) Node(operatorID types.OperatorID) *Node {
+	return h.nodes[operatorID]
+}
+
+// SetMaxJitter sets the max random delay added to every delivery
+func (h *Hub) SetMaxJitter(maxJitter time.Duration) {
+	h.maxJitter = maxJitter
+}
+
+// SetDelay sets the delay of messages sent from one node to another
+func (h *Hub) SetDelay(from, to types.OperatorID, delay time.Duration) {
+	h.delays[[2]types.OperatorID{from, to}] = delay
+}
+
+// Partition splits the network into the given groups, messages between groups are dropped until Heal is called.
+// Nodes which are not in any group form a group of their own.
+func (h *Hub) Partition(groups ...[]types.OperatorID) {
+	h.partitions = map[types.OperatorID]int{}
+	for i, group := range groups {
+		for _, operatorID := range group {
+			h.partitions[operatorID] = i + 1
+		}
+	}
+}
+
+// Heal removes all partitions
+func (h *Hub) Heal() {
+	h.partitions = map[types.OperatorID]int{}
+}
+
+// Now returns the virtual time of the network
+func (h *Hub) Now() time.Time {
+	return h.startTime.Add(h.now)
+}
+
+// Deliveries returns all the deliveries so far
+func (h *Hub) Deliveries() []*Delivery {
+	return h.deliveries
+}
+
+// Step delivers the next pending message, advancing the virtual time to its delivery time. Returns false if there are no pending messages.
+func (h *Hub) Step() bool {
+	if h.queue.Len() == 0 {
+		return false
+	}
+	p := heap.Pop(&h.queue).(*pending)
+	h.now = p.at
+
+	if h.partitioned(p.from, p.to) {
+		return true
+	}
+	h.nodes[p.to].deliver(p)
+	return true
+}
+
+// Run delivers pending messages (including messages broadcasted while handling) until there are none left
+func (h *Hub) Run(maxSteps int) error {
+	for i := 0; i < maxSteps; i++ {
+		if !h.Step() {
+			return nil
+		}
+	}
+	if h.queue.Len() > 0 {
+		return errors.New("max steps reached with pending messages")
+	}
+	return nil
+}
+
+func (h *Hub) partitioned(from, to types.OperatorID) bool {
+	if len(h.partitions) == 0 {
+		return false
+	}
+	return h.partitions[from] != h.partitions[to]
+}
+
+// broadcast schedules the delivery of the message to every node subscribed to the message's validator, including the sender
+func (h *Hub) broadcast(from types.OperatorID, msgID types.MessageID, data []byte) {
+	vpk := string(msgID.GetPubKey())
+	for _, operatorID := range h.sortedOperators() {
+		if !h.nodes[operatorID].subscriptions[vpk] {
+			continue
+		}
+
+		at := h.now + h.delays[[2]types.OperatorID{from, operatorID}]
+		if h.maxJitter > 0 {
+			at += time.Duration(h.rand.Int63n(int64(h.maxJitter)))
+		}
+		h.seq++
+		heap.Push(&h.queue, &pending{
+			from: from,
+			to:   operatorID,
+			at:   at,
+			seq:  h.seq,
+			data: data,
+		})
+	}
+}
+
+func (h *Hub) sortedOperators() []types.OperatorID {
+	ret := make([]types.OperatorID, 0, len(h.nodes))
+	for operatorID := range h.nodes {
+		ret = append(ret, operatorID)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i] < ret[j]
+	})
+	return ret
+}
+
+var (
+	_ p2p.Broadcaster = &Node{}
+	_ p2p.Subscriber  = &Node{}
+)
+
+// Node is a simulated operator in the hub, implementing p2p.Broadcaster and p2p.Subscriber
+type Node struct {
+	OperatorID types.OperatorID
+	PeerID     peer.ID
+	// Validator validates incoming messages before they are handled, nil accepts all messages
+	Validator validation.MsgValidatorFunc
+	// Handler handles accepted messages, nil ignores them
+	Handler MsgHandlerF
+
+	hub           *Hub
+	subscriptions map[string]bool
+}
+
+// Broadcast encodes the message in its wire encoding and schedules its delivery to all the nodes subscribed to its validator
+func (n *Node) Broadcast(msgID types.MessageID, message *types.SignedSSVMessage) error {
+	data, err := wire.EncodeSignedSSVMessage(message, n.hub.WireVersion)
+	if err != nil {
+		return err
+	}
+	n.hub.broadcast(n.OperatorID, msgID, data)
+	return nil
+}
+
+// Subscribe subscribes the node to the messages of the validator
+func (n *Node) Subscribe(vpk types.ValidatorPK) error {
+	n.subscriptions[string(vpk)] = true
+	return nil
+}
+
+// Unsubscribe unsubscribes the node from the messages of the validator
+func (n *Node) Unsubscribe(vpk types.ValidatorPK) {
+	delete(n.subscriptions, string(vpk))
+}
+
+func (n *Node) deliver(p *pending) {
+	delivery := &Delivery{
+		From:   p.from,
+		To:     n.OperatorID,
+		At:     p.at,
+		Data:   p.data,
+		Result: pubsub.ValidationAccept,
+	}
+	n.hub.deliveries = append(n.hub.deliveries, delivery)
+
+	if n.Validator != nil {
+		msg := &pubsub.Message{
+			Message:      &pb.Message{Data: p.data},
+			ReceivedFrom: n.hub.nodes[p.from].PeerID,
+			Local:        p.from == n.OperatorID,
+		}
+		delivery.Result = n.Validator(context.Background(), msg.ReceivedFrom, msg)
+		if delivery.Result != pubsub.ValidationAccept {
+			return
+		}
+	}
+
+	signedMsg, err := wire.DecodeSignedSSVMessage(p.data)
+	if err != nil {
+		delivery.HandlerErr = err
+		return
+	}
+	delivery.Message = signedMsg
+	if n.Handler != nil {
+		delivery.HandlerErr = n.Handler(signedMsg)
+	}
+}
+
+// pending is a scheduled delivery
+type pending struct {
+	from, to types.OperatorID
+	at       time.Duration
+	seq      uint64
+	data     []byte
+}
+
+// pendingQueue is a min heap of pending deliveries ordered by delivery time and then by scheduling order
+type pendingQueue []*pending
+
+func (q pendingQueue) Len() int { return len(q) }
+
+func (q pendingQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q pendingQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *pendingQueue) Push(x interface{}) { *q = append(*q, x.(*pending)) }
+
+func (q *pendingQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
@@ -0,0 +1,108 @@
+package peers
+
+import (
+	"sort"
+
+	"github.com/ssvlabs/ssv-spec/p2p/discovery"
+)
+
+// Direction is the direction of a connection
+type Direction int
+
+const (
+	Inbound Direction = iota
+	Outbound
+)
+
+// PeerInfo is the information about a connected peer used for balancing
+type PeerInfo struct {
+	PeerID    string
+	Direction Direction
+	// Subnets the peer is interested in, as advertised by the peer
+	Subnets discovery.Subnets
+	// Score is the pubsub score of the peer
+	Score float64
+}
+
+// BalancingConfig is the configuration of the peers balancing procedure
+type BalancingConfig struct {
+	// MaxPeers is the peers limit of the node
+	MaxPeers int
+	// MaxInboundRatio is the max ratio of inbound peers among the protected peers
+	MaxInboundRatio float64
+	// BadPeerScore is the pubsub score below which a peer is disconnected regardless of the peers limit
+	BadPeerScore float64
+	// SubnetsCount is the number of subnets in the network
+	SubnetsCount uint64
+}
+
+// BalancingResult holds the peers to protect and the peers to disconnect
+type BalancingResult struct {
+	Protect    []string
+	Disconnect []string
+}
+
+// Balance runs the peers balancing procedure (see "Peers Balancing" in SPEC.md) over the connected peers, returning the peers to protect and to disconnect.
+// Peers with bad behaviour are always disconnected, once the peers limit is reached the best MaxPeers - 1 peers are protected
+// (limiting the ratio of inbound peers) and all other peers are disconnected to free a slot for new peers.
+func Balance(cfg *BalancingConfig, local discovery.Subnets, peers []*PeerInfo) *BalancingResult {
+	ret := &BalancingResult{
+		Protect:    make([]string, 0),
+		Disconnect: make([]string, 0),
+	}
+
+	goodPeers := make([]*PeerInfo, 0, len(peers))
+	for _, p := range peers {
+		if p.Score < cfg.BadPeerScore {
+			ret.Disconnect = append(ret.Disconnect, p.PeerID)
+			continue
+		}
+		goodPeers = append(goodPeers, p)
+	}
+
+	if len(goodPeers) < cfg.MaxPeers {
+		sort.Strings(ret.Disconnect)
+		return ret
+	}
+
+	scores := scorePeers(cfg, local, goodPeers)
+	sort.Slice(goodPeers, func(i, j int) bool {
+		if scores[goodPeers[i].PeerID] != scores[goodPeers[j].PeerID] {
+			return scores[goodPeers[i].PeerID] > scores[goodPeers[j].PeerID]
+		}
+		return goodPeers[i].PeerID < goodPeers[j].PeerID
+	})
+
+	toProtect := cfg.MaxPeers - 1
+	maxInbound := int(cfg.MaxInboundRatio * float64(toProtect))
+	inbound := 0
+	for _, p := range goodPeers {
+		if len(ret.Protect) == toProtect || (p.Direction == Inbound && inbound >= maxInbound) {
+			ret.Disconnect = append(ret.Disconnect, p.PeerID)
+			continue
+		}
+		if p.Direction == Inbound {
+			inbound++
+		}
+		ret.Protect = append(ret.Protect, p.PeerID)
+	}
+
+	sort.Strings(ret.Protect)
+	sort.Strings(ret.Disconnect)
+	return ret
+}
+
+// scorePeers returns the score of each peer according to the subnets it shares with the local node
+func scorePeers(cfg *BalancingConfig, local discovery.Subnets, peers []*PeerInfo) map[string]float64 {
+	peersSubnets := make([]discovery.Subnets, 0, len(peers))
+	for _, p := range peers {
+		peersSubnets = append(peersSubnets, p.Subnets)
+	}
+	subnetScores := discovery.SubnetScores(local, discovery.PeersPerSubnet(cfg.SubnetsCount, peersSubnets))
+
+	ret := make(map[string]float64, len(peers))
+	for _, p := range peers {
+		ret[p.PeerID] = discovery.ScorePeer(local, p.Subnets, subnetScores)
+	}
+	return ret
+}
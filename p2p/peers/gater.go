@@ -0,0 +1,136 @@
+package peers
+
+import (
+	"net"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+// ConnectionGater intercepts connections before they are processed, see "Connection Gating" in SPEC.md.
+// Blocked peers (e.g. pruned for bad behaviour) are refused in both directions and inbound connections are limited per IP.
+// Connected and Disconnected should be called for every connection so the gater can track the number of peers per IP.
+type ConnectionGater struct {
+	// IPColocationLimit is the max number of connected peers from the same IP
+	IPColocationLimit int
+	// IPWhitelist are the networks excluded from the IP colocation limit
+	IPWhitelist []*net.IPNet
+
+	mtx       sync.Mutex
+	blocked   map[peer.ID]bool
+	peerIPs   map[peer.ID]string
+	ipsCounts map[string]int
+}
+
+var _ connmgr.ConnectionGater = &ConnectionGater{}
+
+func NewConnectionGater(ipColocationLimit int, ipWhitelist ...*net.IPNet) *ConnectionGater {
+	return &ConnectionGater{
+		IPColocationLimit: ipColocationLimit,
+		IPWhitelist:       ipWhitelist,
+		blocked:           map[peer.ID]bool{},
+		peerIPs:           map[peer.ID]string{},
+		ipsCounts:         map[string]int{},
+	}
+}
+
+// Block adds the peer to the blocklist
+func (g *ConnectionGater) Block(p peer.ID) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	g.blocked[p] = true
+}
+
+// Unblock removes the peer from the blocklist
+func (g *ConnectionGater) Unblock(p peer.ID) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	delete(g.blocked, p)
+}
+
+// IsBlocked returns true if the peer is in the blocklist
+func (g *ConnectionGater) IsBlocked(p peer.ID) bool {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.blocked[p]
+}
+
+// Connected tracks a new connection of the peer from the given address
+func (g *ConnectionGater) Connected(p peer.ID, addr ma.Multiaddr) {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return
+	}
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	if _, found := g.peerIPs[p]; found {
+		return
+	}
+	g.peerIPs[p] = ip.String()
+	g.ipsCounts[ip.String()]++
+}
+
+// Disconnected stops tracking the connection of the peer
+func (g *ConnectionGater) Disconnected(p peer.ID) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	ip, found := g.peerIPs[p]
+	if !found {
+		return
+	}
+	delete(g.peerIPs, p)
+	g.ipsCounts[ip]--
+	if g.ipsCounts[ip] == 0 {
+		delete(g.ipsCounts, ip)
+	}
+}
+
+// InterceptPeerDial refuses dialing blocked peers
+func (g *ConnectionGater) InterceptPeerDial(p peer.ID) bool {
+	return !g.IsBlocked(p)
+}
+
+// InterceptAddrDial refuses dialing blocked peers
+func (g *ConnectionGater) InterceptAddrDial(p peer.ID, addr ma.Multiaddr) bool {
+	return !g.IsBlocked(p)
+}
+
+// InterceptAccept refuses inbound connections from IPs that reached the IP colocation limit
+func (g *ConnectionGater) InterceptAccept(addrs network.ConnMultiaddrs) bool {
+	ip, err := manet.ToIP(addrs.RemoteMultiaddr())
+	if err != nil {
+		return false
+	}
+	if g.isWhitelisted(ip) {
+		return true
+	}
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.ipsCounts[ip.String()] < g.IPColocationLimit
+}
+
+// InterceptSecured refuses connections of blocked peers, once the remote peer is authenticated
+func (g *ConnectionGater) InterceptSecured(direction network.Direction, p peer.ID, addrs network.ConnMultiaddrs) bool {
+	return !g.IsBlocked(p)
+}
+
+// InterceptUpgraded accepts all upgraded connections
+func (g *ConnectionGater) InterceptUpgraded(conn network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
+
+func (g *ConnectionGater) isWhitelisted(ip net.IP) bool {
+	for _, ipNet := range g.IPWhitelist {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
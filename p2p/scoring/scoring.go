@@ -0,0 +1,281 @@
+package scoring
+
+import (
+	"math"
+	"net"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+)
+
+// Peer score thresholds, see SCORING.md
+const (
+	GossipThreshold             = -4000
+	PublishThreshold            = -8000
+	GraylistThreshold           = -16000
+	AcceptPXThreshold           = 100
+	OpportunisticGraftThreshold = 5
+)
+
+// Topic weights, the total weight of all topics is DecidedTopicWeight + SubnetsTotalWeight
+const (
+	// DecidedTopicWeight is the weight of the decided topic
+	DecidedTopicWeight = 0.5
+	// SubnetsTotalWeight is the weight of all the subnet topics together, each subnet gets SubnetsTotalWeight / subnets
+	SubnetsTotalWeight = 4.0
+)
+
+const (
+	// DefaultIPColocationThreshold is the amount of peers allowed from the same IP
+	DefaultIPColocationThreshold = 10
+
+	slotsPerEpoch = 32
+	// subnetMsgsPerValidatorPerEpoch is the expected number of messages a validator produces on its subnet in one epoch
+	subnetMsgsPerValidatorPerEpoch = 9
+
+	decayToZero = 0.01
+	// maxInMeshScore is the max score a peer can get from its time in a topic's mesh (P1)
+	maxInMeshScore = 10
+	// maxFirstDeliveryScore is the max score a peer can get from first message deliveries in a topic (P2)
+	maxFirstDeliveryScore = 40
+	// timeInMeshCapDuration is the time in mesh after which P1 stops growing
+	timeInMeshCapDuration = time.Hour
+	// maxRequiredMeshRate caps the required mesh deliveries rate (P3) for busy topics
+	maxRequiredMeshRate  = 2.0
+	meshDeliveriesWindow = 2 * time.Second
+	// invalidMsgDecayDisabled is the decay used when invalid messages scoring (P4) is disabled
+	invalidMsgDecayDisabled = 0.1
+
+	behaviourPenaltyThreshold = 10
+	// maxBehaviourPenaltyRate is the number of behaviour penalties per epoch that brings a peer to GossipThreshold
+	maxBehaviourPenaltyRate = 10.0
+	// retainScoreEpochs is the number of epochs a disconnected peer's score is retained, and the decay time of behaviour penalties
+	retainScoreEpochs = 10
+)
+
+// NetworkOpts are the network level arguments for calculating score params
+type NetworkOpts struct {
+	// ActiveValidators is the amount of validators in the network
+	ActiveValidators int
+	// Subnets is the number of subnets in the network
+	Subnets int
+	// OneEpochDuration is used as the time frame of decays
+	OneEpochDuration time.Duration
+	// TotalTopicsWeight is the weight of all the topics in the network (decided + subnets)
+	TotalTopicsWeight float64
+}
+
+// NewNetworkOpts returns network options with the total weight of the decided topic and the subnets
+func NewNetworkOpts(activeValidators, subnets int, oneEpoch time.Duration) NetworkOpts {
+	return NetworkOpts{
+		ActiveValidators:  activeValidators,
+		Subnets:           subnets,
+		OneEpochDuration:  oneEpoch,
+		TotalTopicsWeight: DecidedTopicWeight + SubnetsTotalWeight,
+	}
+}
+
+// maxPositiveScore returns the max score a peer can get from all topics
+func (n NetworkOpts) maxPositiveScore() float64 {
+	return (maxInMeshScore + maxFirstDeliveryScore) * n.TotalTopicsWeight
+}
+
+// TopicOpts are the topic level arguments for calculating topic score params
+type TopicOpts struct {
+	// TopicWeight is the weight of the topic
+	TopicWeight float64
+	// ExpectedMsgRate is the expected rate of messages in the topic per slot
+	ExpectedMsgRate float64
+	// InvalidMsgDecayTime is the decay time in epochs of invalid messages (P4), zero disables scoring of invalid messages
+	InvalidMsgDecayTime uint64
+	// FirstMsgDecayTime is the decay time in epochs of first message deliveries (P2)
+	FirstMsgDecayTime uint64
+	// MeshMsgDecayTime is the decay time in epochs of mesh message deliveries (P3)
+	MeshMsgDecayTime uint64
+	// MeshMsgCapFactor is the factor applied on the mesh message deliveries threshold to get its cap
+	MeshMsgCapFactor float64
+	// MeshMsgActivationTime is the time in mesh before mesh message deliveries penalties are applied
+	MeshMsgActivationTime time.Duration
+	// D is the gossip degree of the topic
+	D int
+}
+
+// DecidedTopicOpts returns the topic options of the decided topic
+func DecidedTopicOpts(network NetworkOpts) TopicOpts {
+	return TopicOpts{
+		TopicWeight:           DecidedTopicWeight,
+		ExpectedMsgRate:       float64(network.ActiveValidators) / slotsPerEpoch,
+		FirstMsgDecayTime:     1,
+		MeshMsgDecayTime:      16,
+		MeshMsgCapFactor:      32.0,
+		MeshMsgActivationTime: network.OneEpochDuration,
+		D:                     8,
+	}
+}
+
+// SubnetTopicOpts returns the topic options of a subnet topic
+func SubnetTopicOpts(network NetworkOpts) TopicOpts {
+	return TopicOpts{
+		TopicWeight:           SubnetsTotalWeight / float64(network.Subnets),
+		ExpectedMsgRate:       float64(network.ActiveValidators) / float64(network.Subnets) * subnetMsgsPerValidatorPerEpoch / slotsPerEpoch,
+		FirstMsgDecayTime:     8,
+		MeshMsgDecayTime:      16,
+		MeshMsgCapFactor:      16.0,
+		MeshMsgActivationTime: network.OneEpochDuration,
+		D:                     8,
+	}
+}
+
+// PeerScoreOpts are the arguments for calculating peer score params
+type PeerScoreOpts struct {
+	// MsgIDCacheTTL is the time message IDs are kept in the seen messages cache
+	MsgIDCacheTTL time.Duration
+	// IPColocationThreshold is the amount of peers allowed from the same IP
+	IPColocationThreshold int
+	// IPColocationWeight is the penalty weight for peers above IPColocationThreshold, zero means -TopicScoreCap
+	IPColocationWeight float64
+	// IPWhitelist are the IPs excluded from IP colocation penalties
+	IPWhitelist []*net.IPNet
+}
+
+// PeerScoreThresholds returns the peer score thresholds
+func PeerScoreThresholds() *pubsub.PeerScoreThresholds {
+	return &pubsub.PeerScoreThresholds{
+		GossipThreshold:             GossipThreshold,
+		PublishThreshold:            PublishThreshold,
+		GraylistThreshold:           GraylistThreshold,
+		AcceptPXThreshold:           AcceptPXThreshold,
+		OpportunisticGraftThreshold: OpportunisticGraftThreshold,
+	}
+}
+
+// PeerScoreParams returns the peer score params, topic params should be added to the returned params' Topics
+func PeerScoreParams(network NetworkOpts, opts PeerScoreOpts) (*pubsub.PeerScoreParams, error) {
+	if network.OneEpochDuration <= 0 {
+		return nil, errors.New("one epoch duration must be positive")
+	}
+
+	topicScoreCap := network.maxPositiveScore() / 4.0
+	ipColocationWeight := opts.IPColocationWeight
+	if ipColocationWeight == 0 {
+		ipColocationWeight = -topicScoreCap
+	}
+
+	// P7, a peer reaching maxBehaviourPenaltyRate penalties per epoch converges to GossipThreshold.
+	// The penalties decay every epoch (DecayInterval), so the rate is taken per epoch as well
+	behaviourPenaltyDecay := scoreDecay(network.OneEpochDuration*retainScoreEpochs, network.OneEpochDuration)
+	targetPenalties, err := decayConvergence(behaviourPenaltyDecay, maxBehaviourPenaltyRate)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not calculate behaviour penalty convergence")
+	}
+	targetPenalties -= behaviourPenaltyThreshold
+
+	return &pubsub.PeerScoreParams{
+		Topics:        map[string]*pubsub.TopicScoreParams{},
+		TopicScoreCap: topicScoreCap,
+		AppSpecificScore: func(p peer.ID) float64 {
+			return 0
+		},
+		AppSpecificWeight:           1,
+		IPColocationFactorWeight:    ipColocationWeight,
+		IPColocationFactorThreshold: opts.IPColocationThreshold,
+		IPColocationFactorWhitelist: opts.IPWhitelist,
+		BehaviourPenaltyWeight:      GossipThreshold / (targetPenalties * targetPenalties),
+		BehaviourPenaltyThreshold:   behaviourPenaltyThreshold,
+		BehaviourPenaltyDecay:       behaviourPenaltyDecay,
+		DecayInterval:               network.OneEpochDuration,
+		DecayToZero:                 decayToZero,
+		RetainScore:                 network.OneEpochDuration * retainScoreEpochs,
+		SeenMsgTTL:                  opts.MsgIDCacheTTL,
+	}, nil
+}
+
+// TopicScoreParams returns the score params of a topic
+func TopicScoreParams(network NetworkOpts, topic TopicOpts) (*pubsub.TopicScoreParams, error) {
+	if network.OneEpochDuration <= 0 {
+		return nil, errors.New("one epoch duration must be positive")
+	}
+	if topic.TopicWeight <= 0 || topic.ExpectedMsgRate <= 0 || topic.D <= 0 {
+		return nil, errors.New("topic weight, expected message rate and D must be positive")
+	}
+	if topic.FirstMsgDecayTime == 0 || topic.MeshMsgDecayTime == 0 {
+		return nil, errors.New("first and mesh message decay times must be positive")
+	}
+
+	oneEpoch := network.OneEpochDuration
+	oneSlot := oneEpoch / slotsPerEpoch
+	maxPositiveScore := network.maxPositiveScore()
+
+	ret := &pubsub.TopicScoreParams{
+		TopicWeight: topic.TopicWeight,
+	}
+
+	// P1
+	ret.TimeInMeshQuantum = oneSlot
+	ret.TimeInMeshCap = float64(timeInMeshCapDuration / oneSlot)
+	ret.TimeInMeshWeight = maxInMeshScore / ret.TimeInMeshCap
+
+	// P2
+	ret.FirstMessageDeliveriesDecay = scoreDecay(oneEpoch*time.Duration(topic.FirstMsgDecayTime), oneEpoch)
+	firstMsgCap, err := decayConvergence(ret.FirstMessageDeliveriesDecay, 2*topic.ExpectedMsgRate/float64(topic.D))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not calculate first message deliveries cap")
+	}
+	ret.FirstMessageDeliveriesCap = firstMsgCap
+	ret.FirstMessageDeliveriesWeight = maxFirstDeliveryScore / firstMsgCap
+
+	// P3, a peer delivering less than the threshold gets a penalty, bounded to twice the max positive score for low rate topics
+	ret.MeshMessageDeliveriesDecay = scoreDecay(oneEpoch*time.Duration(topic.MeshMsgDecayTime), oneEpoch)
+	meshMsgThreshold, err := threshold(ret.MeshMessageDeliveriesDecay, math.Min(topic.ExpectedMsgRate/50, maxRequiredMeshRate))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not calculate mesh message deliveries threshold")
+	}
+	ret.MeshMessageDeliveriesThreshold = meshMsgThreshold
+	ret.MeshMessageDeliveriesCap = meshMsgThreshold * topic.MeshMsgCapFactor
+	ret.MeshMessageDeliveriesWeight = math.Max(
+		-maxPositiveScore/(topic.TopicWeight*ret.MeshMessageDeliveriesCap*ret.MeshMessageDeliveriesCap),
+		-2*maxPositiveScore,
+	)
+	ret.MeshMessageDeliveriesWindow = meshDeliveriesWindow
+	ret.MeshMessageDeliveriesActivation = topic.MeshMsgActivationTime
+
+	// P3b
+	ret.MeshFailurePenaltyWeight = ret.MeshMessageDeliveriesWeight
+	ret.MeshFailurePenaltyDecay = ret.MeshMessageDeliveriesDecay
+
+	// P4
+	if topic.InvalidMsgDecayTime == 0 {
+		ret.InvalidMessageDeliveriesWeight = 0
+		ret.InvalidMessageDeliveriesDecay = invalidMsgDecayDisabled
+	} else {
+		ret.InvalidMessageDeliveriesWeight = -maxPositiveScore / topic.TopicWeight
+		ret.InvalidMessageDeliveriesDecay = scoreDecay(oneEpoch*time.Duration(topic.InvalidMsgDecayTime), oneEpoch)
+	}
+
+	return ret, nil
+}
+
+// scoreDecay returns the decay factor applied every decay interval so that a counter decays to decayToZero after the total duration
+func scoreDecay(totalDecayDuration time.Duration, decayInterval time.Duration) float64 {
+	ticks := float64(totalDecayDuration / decayInterval)
+	return math.Pow(decayToZero, 1/ticks)
+}
+
+// decayConvergence returns the value a counter converges to, given the decay factor and the rate it's incremented per decay interval
+func decayConvergence(decay float64, rate float64) (float64, error) {
+	if decay >= 1 {
+		return 0, errors.New("decay must be smaller than 1")
+	}
+	return rate / (1 - decay), nil
+}
+
+// threshold returns the value a counter is above, right before decaying, if it's incremented at the required rate
+func threshold(decay float64, requiredRate float64) (float64, error) {
+	convergence, err := decayConvergence(decay, requiredRate)
+	if err != nil {
+		return 0, err
+	}
+	return convergence * decay, nil
+}
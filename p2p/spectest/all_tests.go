@@ -0,0 +1,117 @@
+package spectest
+
+import (
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/discovery"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/handshake"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/memnet"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/msgvalidation"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/peers"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/scoring"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/topics"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/wire"
+)
+
+type SpecTest interface {
+	TestName() string
+	Run(t *testing.T)
+}
+
+var AllTests = []SpecTest{
+	msgvalidation.ValidConsensus(),
+	msgvalidation.ValidDecided(),
+	msgvalidation.ValidPartialSig(),
+	msgvalidation.EmptyData(),
+	msgvalidation.MalformedMessage(),
+	msgvalidation.WrongDomain(),
+	msgvalidation.UnknownRole(),
+	msgvalidation.UnknownValidator(),
+	msgvalidation.UnknownMessageType(),
+	msgvalidation.SignerNotInCommittee(),
+	msgvalidation.InvalidSignature(),
+	msgvalidation.InconsistentConsensusSigner(),
+	msgvalidation.InconsistentPartialSigSigner(),
+	msgvalidation.NoConsensusForRole(),
+	msgvalidation.MalformedConsensusMessage(),
+	msgvalidation.IdentifierMismatch(),
+	msgvalidation.NonDecidedWithMultipleSigners(),
+	msgvalidation.DecidedNotEnoughSigners(),
+	msgvalidation.InvalidFullData(),
+	msgvalidation.InvalidQBFTSignature(),
+	msgvalidation.EarlyMessage(),
+	msgvalidation.LateMessage(),
+	msgvalidation.LateProposerMessage(),
+	msgvalidation.RoundTooHigh(),
+	msgvalidation.TooManyMessagesPerRound(),
+	msgvalidation.MessagesPerRoundDifferentTypes(),
+	msgvalidation.RoundAlreadyAdvanced(),
+	msgvalidation.SlotAlreadyAdvanced(),
+	msgvalidation.DifferentPeers(),
+	msgvalidation.DecidedWithSameSigners(),
+	msgvalidation.TooManyDecidedMessages(),
+	msgvalidation.PartialSigTypeMismatch(),
+	msgvalidation.TooManyPartialSigs(),
+	msgvalidation.InvalidPartialSignature(),
+	msgvalidation.BatchValid(),
+	msgvalidation.BatchInvalidSignature(),
+	msgvalidation.BatchDuplicateMessage(),
+	msgvalidation.BatchMalformedMessage(),
+
+	topics.GenesisMainnet(),
+	topics.GenesisJatoV2(),
+	topics.UnknownNetwork(),
+
+	scoring.Validators1K(),
+	scoring.Validators10K(),
+	scoring.Validators51K(),
+	scoring.ZeroEpochDuration(),
+
+	handshake.Valid(),
+	handshake.WrongNetworkID(),
+	handshake.WrongDomain(),
+	handshake.PeerIDMismatch(),
+	handshake.UnknownOperator(),
+	handshake.InvalidSignature(),
+	handshake.SignatureWithoutDomain(),
+
+	discovery.NoSubnets(),
+	discovery.SingleSubnet(),
+	discovery.MultipleSubnets(),
+	discovery.AllSubnets(),
+	discovery.WrongSubnetsCount(),
+	discovery.SubnetOutOfRange(),
+	discovery.FindPeers(),
+	discovery.FindPeersMinScore(),
+	discovery.FindPeersLimit(),
+	discovery.FindPeersNoConnectedPeers(),
+
+	peers.BelowPeersLimit(),
+	peers.BadPeers(),
+	peers.TrimPeers(),
+	peers.InboundRatio(),
+	peers.Blocklist(),
+	peers.IPColocation(),
+	peers.IPWhitelist(),
+
+	memnet.RoutesBySubscription(),
+	memnet.Delays(),
+	memnet.Partition(),
+	memnet.SeededJitter(),
+	memnet.MessageValidation(),
+
+	wire.RoundTripConsensus(),
+	wire.RoundTripPartialSig(),
+	wire.RoundTripVariableSignature(),
+	wire.LegacyVersionShortSignature(),
+	wire.MaxSize(),
+	wire.EmptyData(),
+	wire.UnknownVersion(),
+	wire.CorruptData(),
+	wire.MalformedMessage(),
+	wire.MalformedLegacyMessage(),
+	wire.DecompressedTooBig(),
+	wire.CompressedTooBig(),
+	wire.UncompressedAccepted(),
+	wire.UncompressedRejected(),
+}
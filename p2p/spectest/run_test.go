@@ -0,0 +1,116 @@
+package spectest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/discovery"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/handshake"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/memnet"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/msgvalidation"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/peers"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/scoring"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/topics"
+	"github.com/ssvlabs/ssv-spec/p2p/spectest/tests/wire"
+)
+
+func TestAll(t *testing.T) {
+	for _, test := range AllTests {
+		t.Run(test.TestName(), func(t *testing.T) {
+			test.Run(t)
+		})
+	}
+}
+
+func TestJson(t *testing.T) {
+	basedir, _ := os.Getwd()
+	path := filepath.Join(basedir, "generate", "tests.json")
+	untypedTests := map[string]interface{}{}
+	byteValue, err := os.ReadFile(path)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	if err := json.Unmarshal(byteValue, &untypedTests); err != nil {
+		panic(err.Error())
+	}
+
+	fmt.Printf("running %d tests\n", len(untypedTests))
+	for name, test := range untypedTests {
+		testName := test.(map[string]interface{})["Name"].(string)
+		t.Run(testName, func(t *testing.T) {
+			testType := strings.Split(name, "_")[0]
+			switch testType {
+			case reflect.TypeOf(&msgvalidation.MsgValidationTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &msgvalidation.MsgValidationTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&topics.TopicsSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &topics.TopicsSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&scoring.ScoringSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &scoring.ScoringSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&handshake.HandshakeSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &handshake.HandshakeSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&discovery.SubnetsEntrySpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &discovery.SubnetsEntrySpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&discovery.DiscoverySpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &discovery.DiscoverySpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&peers.BalancingSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &peers.BalancingSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&peers.GaterSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &peers.GaterSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&memnet.HubSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &memnet.HubSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&wire.WireSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &wire.WireSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			default:
+				t.Fatalf("unknown test")
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ssvlabs/ssv-spec/p2p/discovery"
+	"github.com/stretchr/testify/require"
+)
+
+// Peer is a peer and the subnets it's interested in
+type Peer struct {
+	PeerID  string
+	Subnets []uint64
+}
+
+// ExpectedCandidate is a peer expected to be found with the given score
+type ExpectedCandidate struct {
+	PeerID string
+	Score  float64
+}
+
+// DiscoverySpecTest advertises the records of peers in an in memory discovery and tests the candidates found for the local node
+type DiscoverySpecTest struct {
+	Name               string
+	SubnetsCount       uint64
+	LocalSubnets       []uint64
+	Connected          []*Peer
+	Advertised         []*Peer
+	MinScore           float64
+	Limit              int
+	ExpectedCandidates []*ExpectedCandidate
+}
+
+func (test *DiscoverySpecTest) TestName() string {
+	return "discovery " + test.Name
+}
+
+func (test *DiscoverySpecTest) Run(t *testing.T) {
+	d := discovery.NewMemDiscovery(test.SubnetsCount)
+	for _, p := range test.Advertised {
+		record := &enr.Record{}
+		discovery.SetSubnetsEntry(record, subnetsOf(t, test.SubnetsCount, p.Subnets))
+		d.Advertise(p.PeerID, record)
+	}
+
+	connected := map[string]discovery.Subnets{}
+	for _, p := range test.Connected {
+		connected[p.PeerID] = subnetsOf(t, test.SubnetsCount, p.Subnets)
+	}
+
+	candidates := d.FindPeers(subnetsOf(t, test.SubnetsCount, test.LocalSubnets), connected, test.MinScore, test.Limit)
+	require.Len(t, candidates, len(test.ExpectedCandidates))
+	for i, expected := range test.ExpectedCandidates {
+		require.EqualValues(t, expected.PeerID, candidates[i].PeerID)
+		require.EqualValues(t, expected.Score, candidates[i].Score)
+	}
+}
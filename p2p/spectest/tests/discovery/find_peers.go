@@ -0,0 +1,96 @@
+package discovery
+
+// overflowConnected are connected peers overflowing subnet 1 (5 peers) with one peer in subnet 2 and none in subnet 3
+func overflowConnected() []*Peer {
+	return []*Peer{
+		{PeerID: "connected-1", Subnets: []uint64{1}},
+		{PeerID: "connected-2", Subnets: []uint64{1}},
+		{PeerID: "connected-3", Subnets: []uint64{1}},
+		{PeerID: "connected-4", Subnets: []uint64{1}},
+		{PeerID: "connected-5", Subnets: []uint64{1, 2}},
+	}
+}
+
+func advertised() []*Peer {
+	return []*Peer{
+		{PeerID: "peer-a", Subnets: []uint64{1}},
+		{PeerID: "peer-b", Subnets: []uint64{2}},
+		{PeerID: "peer-c", Subnets: []uint64{3}},
+		{PeerID: "peer-d", Subnets: []uint64{2, 3}},
+		{PeerID: "peer-e", Subnets: []uint64{4}},
+		{PeerID: "connected-5", Subnets: []uint64{1, 2}},
+	}
+}
+
+// FindPeers tests candidates are scored by the subnets they cover, peers without shared subnets or already connected are skipped
+func FindPeers() *DiscoverySpecTest {
+	return &DiscoverySpecTest{
+		Name:         "find peers",
+		SubnetsCount: 128,
+		LocalSubnets: []uint64{1, 2, 3},
+		Connected:    overflowConnected(),
+		Advertised:   advertised(),
+		MinScore:     -10,
+		Limit:        10,
+		ExpectedCandidates: []*ExpectedCandidate{
+			{PeerID: "peer-d", Score: 3.5},
+			{PeerID: "peer-c", Score: 2.25},
+			{PeerID: "peer-b", Score: 1.25},
+			{PeerID: "peer-a", Score: -0.75},
+		},
+	}
+}
+
+// FindPeersMinScore tests candidates at or below the min score are skipped
+func FindPeersMinScore() *DiscoverySpecTest {
+	return &DiscoverySpecTest{
+		Name:         "min score",
+		SubnetsCount: 128,
+		LocalSubnets: []uint64{1, 2, 3},
+		Connected:    overflowConnected(),
+		Advertised:   advertised(),
+		MinScore:     0,
+		Limit:        10,
+		ExpectedCandidates: []*ExpectedCandidate{
+			{PeerID: "peer-d", Score: 3.5},
+			{PeerID: "peer-c", Score: 2.25},
+			{PeerID: "peer-b", Score: 1.25},
+		},
+	}
+}
+
+// FindPeersLimit tests only the best candidates are returned
+func FindPeersLimit() *DiscoverySpecTest {
+	return &DiscoverySpecTest{
+		Name:         "limit",
+		SubnetsCount: 128,
+		LocalSubnets: []uint64{1, 2, 3},
+		Connected:    overflowConnected(),
+		Advertised:   advertised(),
+		MinScore:     0,
+		Limit:        2,
+		ExpectedCandidates: []*ExpectedCandidate{
+			{PeerID: "peer-d", Score: 3.5},
+			{PeerID: "peer-c", Score: 2.25},
+		},
+	}
+}
+
+// FindPeersNoConnectedPeers tests equally scored candidates are ordered by peer id
+func FindPeersNoConnectedPeers() *DiscoverySpecTest {
+	return &DiscoverySpecTest{
+		Name:         "no connected peers",
+		SubnetsCount: 128,
+		LocalSubnets: []uint64{2, 3},
+		Connected:    []*Peer{},
+		Advertised:   advertised(),
+		MinScore:     0,
+		Limit:        10,
+		ExpectedCandidates: []*ExpectedCandidate{
+			{PeerID: "peer-d", Score: 4.5},
+			{PeerID: "connected-5", Score: 2.25},
+			{PeerID: "peer-b", Score: 2.25},
+			{PeerID: "peer-c", Score: 2.25},
+		},
+	}
+}
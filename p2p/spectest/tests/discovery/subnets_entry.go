@@ -0,0 +1,86 @@
+package discovery
+
+import (
+	"encoding/hex"
+)
+
+func entryBytes(s string) []byte {
+	byts, _ := hex.DecodeString(s)
+	return byts
+}
+
+// NoSubnets tests the entry of a node without subnets
+func NoSubnets() *SubnetsEntrySpecTest {
+	return &SubnetsEntrySpecTest{
+		Name:               "no subnets",
+		SubnetsCount:       128,
+		Subnets:            []uint64{},
+		DecodeSubnetsCount: 128,
+		ExpectedHex:        "00000000000000000000000000000000",
+		ExpectedENREntry:   entryBytes("9000000000000000000000000000000000"),
+	}
+}
+
+// SingleSubnet tests the entry of a node with one subnet
+func SingleSubnet() *SubnetsEntrySpecTest {
+	return &SubnetsEntrySpecTest{
+		Name:               "single subnet",
+		SubnetsCount:       128,
+		Subnets:            []uint64{0},
+		DecodeSubnetsCount: 128,
+		ExpectedHex:        "01000000000000000000000000000000",
+		ExpectedENREntry:   entryBytes("9001000000000000000000000000000000"),
+	}
+}
+
+// MultipleSubnets tests the bit order of the bitfield
+func MultipleSubnets() *SubnetsEntrySpecTest {
+	return &SubnetsEntrySpecTest{
+		Name:               "multiple subnets",
+		SubnetsCount:       128,
+		Subnets:            []uint64{0, 1, 9, 66, 127},
+		DecodeSubnetsCount: 128,
+		ExpectedHex:        "03020000000000000400000000000080",
+		ExpectedENREntry:   entryBytes("9003020000000000000400000000000080"),
+	}
+}
+
+// AllSubnets tests the entry of a node interested in all subnets
+func AllSubnets() *SubnetsEntrySpecTest {
+	all := make([]uint64, 128)
+	for i := range all {
+		all[i] = uint64(i)
+	}
+	return &SubnetsEntrySpecTest{
+		Name:               "all subnets",
+		SubnetsCount:       128,
+		Subnets:            all,
+		DecodeSubnetsCount: 128,
+		ExpectedHex:        "ffffffffffffffffffffffffffffffff",
+		ExpectedENREntry:   entryBytes("90ffffffffffffffffffffffffffffffff"),
+	}
+}
+
+// WrongSubnetsCount tests decoding an entry of another number of subnets
+func WrongSubnetsCount() *SubnetsEntrySpecTest {
+	return &SubnetsEntrySpecTest{
+		Name:               "wrong subnets count",
+		SubnetsCount:       128,
+		Subnets:            []uint64{3},
+		DecodeSubnetsCount: 64,
+		ExpectedHex:        "08000000000000000000000000000000",
+		ExpectedENREntry:   entryBytes("9008000000000000000000000000000000"),
+		ExpectedError:      "wrong subnets bitfield size",
+	}
+}
+
+// SubnetOutOfRange tests marking a subnet out of the bitfield's range
+func SubnetOutOfRange() *SubnetsEntrySpecTest {
+	return &SubnetsEntrySpecTest{
+		Name:               "subnet out of range",
+		SubnetsCount:       128,
+		Subnets:            []uint64{3, 128},
+		DecodeSubnetsCount: 128,
+		ExpectedError:      "subnet 128 out of range",
+	}
+}
@@ -0,0 +1,64 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ssvlabs/ssv-spec/p2p/discovery"
+	"github.com/stretchr/testify/require"
+)
+
+// SubnetsEntrySpecTest tests the encoding of a subnets bitfield and its ENR entry
+type SubnetsEntrySpecTest struct {
+	Name         string
+	SubnetsCount uint64
+	Subnets      []uint64
+	// DecodeSubnetsCount is the number of subnets expected when decoding the entry
+	DecodeSubnetsCount uint64
+	ExpectedHex        string
+	ExpectedENREntry   []byte
+	ExpectedError      string
+}
+
+func (test *SubnetsEntrySpecTest) TestName() string {
+	return "subnets entry " + test.Name
+}
+
+func (test *SubnetsEntrySpecTest) Run(t *testing.T) {
+	subnets := discovery.NewSubnets(test.SubnetsCount)
+	for _, subnet := range test.Subnets {
+		if err := subnets.Set(subnet); err != nil {
+			require.EqualError(t, err, test.ExpectedError)
+			return
+		}
+	}
+	require.EqualValues(t, test.ExpectedHex, subnets.String())
+	require.EqualValues(t, test.Subnets, subnets.Active())
+
+	entry, err := rlp.EncodeToBytes(subnets)
+	require.NoError(t, err)
+	require.EqualValues(t, test.ExpectedENREntry, entry)
+
+	record := &enr.Record{}
+	discovery.SetSubnetsEntry(record, subnets)
+	decoded, err := discovery.GetSubnetsEntry(record, test.DecodeSubnetsCount)
+	if len(test.ExpectedError) > 0 {
+		require.EqualError(t, err, test.ExpectedError)
+		return
+	}
+	require.NoError(t, err)
+	require.EqualValues(t, subnets, decoded)
+
+	fromHex, err := discovery.SubnetsFromHex(test.ExpectedHex, test.DecodeSubnetsCount)
+	require.NoError(t, err)
+	require.EqualValues(t, subnets, fromHex)
+}
+
+func subnetsOf(t *testing.T, subnetsCount uint64, active []uint64) discovery.Subnets {
+	ret := discovery.NewSubnets(subnetsCount)
+	for _, subnet := range active {
+		require.NoError(t, ret.Set(subnet))
+	}
+	return ret
+}
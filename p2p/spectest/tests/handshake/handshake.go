@@ -0,0 +1,123 @@
+package handshake
+
+import (
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+const (
+	localPeer  = "local-peer"
+	remotePeer = "remote-peer"
+)
+
+func localNode() *Node {
+	return &Node{
+		NetworkID:  types.JatoV2NetworkID,
+		Domain:     types.JatoV2Testnet,
+		PeerID:     localPeer,
+		OperatorID: 1,
+		SignerID:   1,
+	}
+}
+
+func remoteNode() *Node {
+	return &Node{
+		NetworkID:  types.JatoV2NetworkID,
+		Domain:     types.JatoV2Testnet,
+		PeerID:     remotePeer,
+		OperatorID: 2,
+		SignerID:   2,
+	}
+}
+
+// Valid tests a handshake between two nodes of the same network and fork
+func Valid() *HandshakeSpecTest {
+	return &HandshakeSpecTest{
+		Name:         "valid",
+		Local:        localNode(),
+		Remote:       remoteNode(),
+		RemotePeerID: remotePeer,
+	}
+}
+
+// WrongNetworkID tests a remote node on another network
+func WrongNetworkID() *HandshakeSpecTest {
+	remote := remoteNode()
+	remote.NetworkID = types.MainnetNetworkID
+
+	return &HandshakeSpecTest{
+		Name:          "wrong network id",
+		Local:         localNode(),
+		Remote:        remote,
+		RemotePeerID:  remotePeer,
+		ExpectedError: "wrong network id",
+	}
+}
+
+// WrongDomain tests a remote node on the same network but another fork
+func WrongDomain() *HandshakeSpecTest {
+	remote := remoteNode()
+	remote.Domain = types.JatoTestnet
+
+	return &HandshakeSpecTest{
+		Name:          "wrong domain",
+		Local:         localNode(),
+		Remote:        remote,
+		RemotePeerID:  remotePeer,
+		ExpectedError: "wrong domain",
+	}
+}
+
+// PeerIDMismatch tests a node info replayed by another peer
+func PeerIDMismatch() *HandshakeSpecTest {
+	return &HandshakeSpecTest{
+		Name:          "peer id mismatch",
+		Local:         localNode(),
+		Remote:        remoteNode(),
+		RemotePeerID:  "other-peer",
+		ExpectedError: "peer id doesn't match remote peer",
+	}
+}
+
+// UnknownOperator tests a remote node of an operator the local node doesn't know
+func UnknownOperator() *HandshakeSpecTest {
+	remote := remoteNode()
+	remote.OperatorID = 5
+	remote.SignerID = 5
+
+	return &HandshakeSpecTest{
+		Name:          "unknown operator",
+		Local:         localNode(),
+		Remote:        remote,
+		RemotePeerID:  remotePeer,
+		ExpectedError: "unknown operator",
+	}
+}
+
+// InvalidSignature tests a node info signed with another operator's key
+func InvalidSignature() *HandshakeSpecTest {
+	remote := remoteNode()
+	remote.SignerID = 3
+
+	return &HandshakeSpecTest{
+		Name:          "invalid signature",
+		Local:         localNode(),
+		Remote:        remote,
+		RemotePeerID:  remotePeer,
+		ExpectedError: "invalid node info signature: crypto/rsa: verification error",
+	}
+}
+
+// SignatureWithoutDomain tests a node info signature without the handshake signature domain, e.g. a SignedSSVMessage signature
+// over the same bytes
+func SignatureWithoutDomain() *HandshakeSpecTest {
+	remote := remoteNode()
+	remote.SignWithoutDomain = true
+
+	return &HandshakeSpecTest{
+		Name:          "signature without domain",
+		Local:         localNode(),
+		Remote:        remote,
+		RemotePeerID:  remotePeer,
+		ExpectedError: "invalid node info signature: crypto/rsa: verification error",
+	}
+}
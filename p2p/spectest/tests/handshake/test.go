@@ -0,0 +1,109 @@
+package handshake
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/p2p/handshake"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Node is a handshake participant, the node signs its info with SignerID's network key
+type Node struct {
+	NetworkID  types.NetworkID
+	Domain     types.DomainType
+	PeerID     string
+	OperatorID types.OperatorID
+	SignerID   types.OperatorID
+	// SignWithoutDomain signs the node info as a SignedSSVMessage's data, without handshake.SignatureDomain
+	SignWithoutDomain bool `json:",omitempty"`
+}
+
+// HandshakeSpecTest runs a handshake between two in process nodes, checking the local node's verification of the remote node.
+// Both nodes know the operators of testingutils.Testing4SharesSet.
+type HandshakeSpecTest struct {
+	Name string
+	// Local is the node verifying the handshake
+	Local *Node
+	// Remote is the node sending its info
+	Remote *Node
+	// RemotePeerID is the peer id of the remote node's connection as seen by the local node
+	RemotePeerID  string
+	ExpectedError string
+}
+
+func (test *HandshakeSpecTest) TestName() string {
+	return "handshake " + test.Name
+}
+
+func (test *HandshakeSpecTest) Run(t *testing.T) {
+	local := test.Local.handshaker()
+	remote := test.Remote.handshaker()
+
+	localConn, remoteConn := net.Pipe()
+	defer localConn.Close()
+	defer remoteConn.Close()
+
+	go func() {
+		_, _ = remote.Handshake(remoteConn, test.Local.PeerID)
+	}()
+	info, err := local.Handshake(localConn, test.RemotePeerID)
+
+	if len(test.ExpectedError) > 0 {
+		require.EqualError(t, err, test.ExpectedError)
+		return
+	}
+	require.NoError(t, err)
+	require.EqualValues(t, remote.NodeInfo, info)
+}
+
+func (n *Node) handshaker() *handshake.Handshaker {
+	committee := testingutils.Testing4SharesSet().Committee()
+	getOperator := func(operatorID types.OperatorID) *types.Operator {
+		for _, operator := range committee {
+			if operator.OperatorID == operatorID {
+				return operator
+			}
+		}
+		return nil
+	}
+
+	// operators which are not in the committee sign with a key of a bigger key set
+	signerKeySet := testingutils.Testing4SharesSet()
+	if _, found := signerKeySet.OperatorKeys[n.SignerID]; !found {
+		signerKeySet = testingutils.Testing7SharesSet()
+	}
+
+	var signer types.OperatorSigner = testingutils.NewTestingOperatorSigner(signerKeySet, n.SignerID)
+	if n.SignWithoutDomain {
+		signer = &noDomainSigner{OperatorSigner: signer}
+	}
+
+	return handshake.NewHandshaker(
+		&handshake.NodeInfo{
+			NetworkID: n.NetworkID,
+			Domain:    n.Domain,
+			PeerID:    n.PeerID,
+			Metadata: &handshake.NodeMetadata{
+				OperatorID:  n.OperatorID,
+				Subnets:     "ffffffffffffffffffffffffffffffff",
+				NodeVersion: "v1.0.0",
+			},
+		},
+		signer,
+		testingutils.NewTestingVerifier(),
+		getOperator,
+	)
+}
+
+// noDomainSigner signs the node info without the handshake signature domain, as a SignedSSVMessage's data
+type noDomainSigner struct {
+	types.OperatorSigner
+}
+
+func (s *noDomainSigner) SignSSVMessage(data []byte) ([]byte, error) {
+	return s.OperatorSigner.SignSSVMessage(bytes.TrimPrefix(data, handshake.SignatureDomain))
+}
@@ -0,0 +1,147 @@
+package memnet
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+var operators = []types.OperatorID{1, 2, 3, 4}
+
+// subscribeAll subscribes all the operators to the testing validator
+func subscribeAll() []*Subscription {
+	ret := make([]*Subscription, 0)
+	for _, operatorID := range operators {
+		ret = append(ret, &Subscription{OperatorID: operatorID, ValidatorPK: testingutils.TestingValidatorPubKey[:]})
+	}
+	return ret
+}
+
+// prepareMsg returns an attester prepare message of the operator for the testing duty slot
+func prepareMsg(operatorID types.OperatorID) *types.SignedSSVMessage {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.TestingPrepareMessageWithParams(ks.Shares[operatorID], operatorID, qbft.FirstRound, qbft.Height(testingutils.TestingDutySlot), testingutils.AttesterMsgID, testingutils.TestingQBFTRootData)
+	return testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(msg, nil))
+}
+
+// RoutesBySubscription tests messages are delivered, including to the sender, only to nodes subscribed to the message's validator
+func RoutesBySubscription() *HubSpecTest {
+	return &HubSpecTest{
+		Name:      "routes by subscription",
+		Seed:      1,
+		Operators: operators,
+		Subscriptions: []*Subscription{
+			{OperatorID: 1, ValidatorPK: testingutils.TestingValidatorPubKey[:]},
+			{OperatorID: 2, ValidatorPK: testingutils.TestingValidatorPubKey[:]},
+			{OperatorID: 3, ValidatorPK: testingutils.TestingWrongValidatorPubKey[:]},
+		},
+		Broadcasts: []*Broadcast{{From: 1, Message: prepareMsg(1)}},
+		ExpectedDeliveries: []*ExpectedDelivery{
+			{To: 1, Broadcast: 0, Result: pubsub.ValidationAccept},
+			{To: 2, Broadcast: 0, Result: pubsub.ValidationAccept},
+		},
+	}
+}
+
+// Delays tests messages are delivered in order of their delivery time
+func Delays() *HubSpecTest {
+	return &HubSpecTest{
+		Name:          "delays",
+		Seed:          1,
+		Operators:     operators,
+		Subscriptions: subscribeAll(),
+		Delays: []*Delay{
+			{From: 1, To: 2, Delay: 300 * time.Millisecond},
+			{From: 1, To: 3, Delay: 100 * time.Millisecond},
+			{From: 2, To: 1, Delay: 200 * time.Millisecond},
+		},
+		Broadcasts: []*Broadcast{
+			{From: 1, Message: prepareMsg(1)},
+			{From: 2, Message: prepareMsg(2)},
+		},
+		ExpectedDeliveries: []*ExpectedDelivery{
+			{To: 1, Broadcast: 0, Result: pubsub.ValidationAccept},
+			{To: 4, Broadcast: 0, Result: pubsub.ValidationAccept},
+			{To: 2, Broadcast: 1, Result: pubsub.ValidationAccept},
+			{To: 3, Broadcast: 1, Result: pubsub.ValidationAccept},
+			{To: 4, Broadcast: 1, Result: pubsub.ValidationAccept},
+			{To: 3, Broadcast: 0, At: 100 * time.Millisecond, Result: pubsub.ValidationAccept},
+			{To: 1, Broadcast: 1, At: 200 * time.Millisecond, Result: pubsub.ValidationAccept},
+			{To: 2, Broadcast: 0, At: 300 * time.Millisecond, Result: pubsub.ValidationAccept},
+		},
+	}
+}
+
+// Partition tests messages are not delivered across partitions
+func Partition() *HubSpecTest {
+	return &HubSpecTest{
+		Name:          "partition",
+		Seed:          1,
+		Operators:     operators,
+		Subscriptions: subscribeAll(),
+		Partitions:    [][]types.OperatorID{{1, 2}, {3, 4}},
+		Broadcasts: []*Broadcast{
+			{From: 1, Message: prepareMsg(1)},
+			{From: 3, Message: prepareMsg(3)},
+		},
+		ExpectedDeliveries: []*ExpectedDelivery{
+			{To: 1, Broadcast: 0, Result: pubsub.ValidationAccept},
+			{To: 2, Broadcast: 0, Result: pubsub.ValidationAccept},
+			{To: 3, Broadcast: 1, Result: pubsub.ValidationAccept},
+			{To: 4, Broadcast: 1, Result: pubsub.ValidationAccept},
+		},
+	}
+}
+
+// SeededJitter tests deliveries with a random jitter are deterministic for the seed
+func SeededJitter() *HubSpecTest {
+	return &HubSpecTest{
+		Name:          "seeded jitter",
+		Seed:          42,
+		MaxJitter:     100 * time.Millisecond,
+		Operators:     operators,
+		Subscriptions: subscribeAll(),
+		Broadcasts:    []*Broadcast{{From: 1, Message: prepareMsg(1)}},
+		ExpectedDeliveries: []*ExpectedDelivery{
+			{To: 3, Broadcast: 0, At: 1878760, Result: pubsub.ValidationAccept},
+			{To: 4, Broadcast: 0, At: 26624009, Result: pubsub.ValidationAccept},
+			{To: 1, Broadcast: 0, At: 31278675, Result: pubsub.ValidationAccept},
+			{To: 2, Broadcast: 0, At: 43856411, Result: pubsub.ValidationAccept},
+		},
+	}
+}
+
+// MessageValidation tests messages are validated by every receiving node before they are handled
+func MessageValidation() *HubSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	ssvMsg, err := prepareMsg(2).GetSSVMessageFromData()
+	if err != nil {
+		panic(err.Error())
+	}
+	invalidSig := testingutils.SignedSSVMessageWithSigner(2, ks.OperatorKeys[3], ssvMsg)
+
+	return &HubSpecTest{
+		Name:             "message validation",
+		Seed:             1,
+		Operators:        operators,
+		Subscriptions:    subscribeAll(),
+		ValidateMessages: true,
+		Broadcasts: []*Broadcast{
+			{From: 1, Message: prepareMsg(1)},
+			{From: 2, Message: invalidSig},
+		},
+		ExpectedDeliveries: []*ExpectedDelivery{
+			{To: 1, Broadcast: 0, Result: pubsub.ValidationAccept},
+			{To: 2, Broadcast: 0, Result: pubsub.ValidationAccept},
+			{To: 3, Broadcast: 0, Result: pubsub.ValidationAccept},
+			{To: 4, Broadcast: 0, Result: pubsub.ValidationAccept},
+			{To: 1, Broadcast: 1, Result: pubsub.ValidationReject},
+			{To: 2, Broadcast: 1, Result: pubsub.ValidationReject},
+			{To: 3, Broadcast: 1, Result: pubsub.ValidationReject},
+			{To: 4, Broadcast: 1, Result: pubsub.ValidationReject},
+		},
+	}
+}
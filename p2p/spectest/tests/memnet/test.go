@@ -0,0 +1,122 @@
+package memnet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/ssvlabs/ssv-spec/p2p/memnet"
+	"github.com/ssvlabs/ssv-spec/p2p/validation"
+	"github.com/ssvlabs/ssv-spec/p2p/wire"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Subscription subscribes the operator's node to the validator
+type Subscription struct {
+	OperatorID  types.OperatorID
+	ValidatorPK types.ValidatorPK
+}
+
+// Delay delays the messages sent from one node to another
+type Delay struct {
+	From, To types.OperatorID
+	Delay    time.Duration
+}
+
+// Broadcast is a message broadcasted by a node when the network starts
+type Broadcast struct {
+	From    types.OperatorID
+	Message *types.SignedSSVMessage
+}
+
+// ExpectedDelivery is a delivery of the broadcast at the given index
+type ExpectedDelivery struct {
+	To        types.OperatorID
+	Broadcast int
+	At        time.Duration
+	Result    pubsub.ValidationResult
+}
+
+// HubSpecTest runs an in memory network of the given operators and tests the deliveries of the broadcasted messages, in order
+type HubSpecTest struct {
+	Name          string
+	Seed          int64
+	MaxJitter     time.Duration
+	Operators     []types.OperatorID
+	Subscriptions []*Subscription
+	Delays        []*Delay
+	Partitions    [][]types.OperatorID
+	// ValidateMessages runs a message validator for testingutils.Testing4SharesSet's share on every node,
+	// the network starts at the beginning of testingutils.TestingDutySlot
+	ValidateMessages   bool
+	Broadcasts         []*Broadcast
+	ExpectedDeliveries []*ExpectedDelivery
+}
+
+func (test *HubSpecTest) TestName() string {
+	return "memnet " + test.Name
+}
+
+func (test *HubSpecTest) Run(t *testing.T) {
+	startTime := time.Unix(types.BeaconTestNetwork.EstimatedTimeAtSlot(testingutils.TestingDutySlot), 0)
+	hub := memnet.NewHub(test.Seed, startTime)
+	hub.SetMaxJitter(test.MaxJitter)
+	for _, operatorID := range test.Operators {
+		node := hub.AddNode(operatorID)
+		if test.ValidateMessages {
+			node.Validator = messageValidator(hub)
+		}
+	}
+	for _, s := range test.Subscriptions {
+		require.NoError(t, hub.Node(s.OperatorID).Subscribe(s.ValidatorPK))
+	}
+	for _, d := range test.Delays {
+		hub.SetDelay(d.From, d.To, d.Delay)
+	}
+	if len(test.Partitions) > 0 {
+		hub.Partition(test.Partitions...)
+	}
+
+	encoded := make([][]byte, 0, len(test.Broadcasts))
+	for _, b := range test.Broadcasts {
+		ssvMsg, err := b.Message.GetSSVMessageFromData()
+		require.NoError(t, err)
+		require.NoError(t, hub.Node(b.From).Broadcast(ssvMsg.GetID(), b.Message))
+
+		byts, err := wire.EncodeSignedSSVMessage(b.Message, hub.WireVersion)
+		require.NoError(t, err)
+		encoded = append(encoded, byts)
+	}
+	require.NoError(t, hub.Run(1000))
+
+	deliveries := hub.Deliveries()
+	require.Len(t, deliveries, len(test.ExpectedDeliveries))
+	for i, expected := range test.ExpectedDeliveries {
+		require.EqualValues(t, expected.To, deliveries[i].To, "delivery %d", i)
+		require.EqualValues(t, test.Broadcasts[expected.Broadcast].From, deliveries[i].From, "delivery %d", i)
+		require.True(t, bytes.Equal(encoded[expected.Broadcast], deliveries[i].Data), "delivery %d", i)
+		require.EqualValues(t, expected.At, deliveries[i].At, "delivery %d", i)
+		require.EqualValues(t, expected.Result, deliveries[i].Result, "delivery %d", i)
+		require.NoError(t, deliveries[i].HandlerErr, "delivery %d", i)
+	}
+}
+
+// messageValidator returns a message validator validating messages at the hub's virtual time
+func messageValidator(hub *memnet.Hub) validation.MsgValidatorFunc {
+	share := testingutils.TestingShare(testingutils.Testing4SharesSet())
+	mv := validation.NewMessageValidator(
+		testingutils.TestingSSVDomainType,
+		types.BeaconTestNetwork,
+		testingutils.NewTestingVerifier(),
+		func(validatorPK []byte) *types.Share {
+			if bytes.Equal(validatorPK, share.ValidatorPubKey) {
+				return share
+			}
+			return nil
+		},
+	)
+	return validation.MsgValidationAt(mv, hub.Now)
+}
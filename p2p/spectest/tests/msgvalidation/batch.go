@@ -0,0 +1,83 @@
+package msgvalidation
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/ssvlabs/ssv-spec/p2p/validation"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// BatchValid tests the committee's partial signature messages validated at once
+func BatchValid() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name: "batch valid",
+		Messages: fromPeer(
+			encodedMsg(ks, testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, testingutils.TestingDutySlot))),
+			encodedMsg(ks, testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[2], 2, testingutils.TestingDutySlot))),
+			encodedMsg(ks, testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[3], 3, testingutils.TestingDutySlot))),
+			encodedMsg(ks, testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[4], 4, testingutils.TestingDutySlot))),
+		),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        4 * time.Second,
+		ExpectedErrors:  []string{"", "", "", ""},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationAccept, pubsub.ValidationAccept, pubsub.ValidationAccept},
+		Batch:           true,
+	}
+}
+
+// BatchInvalidSignature tests a batch with a message with an invalid operator signature, the other messages are accepted
+func BatchInvalidSignature() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	signed := testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 2), nil))
+	signed.Signature = testingutils.TestingSignedSSVMessageSignature
+
+	return &MsgValidationTest{
+		Name: "batch invalid signature",
+		Messages: fromPeer(
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)),
+			encodeSigned(signed),
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 3)),
+		),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{"", validation.ErrInvalidSignature.Error(), ""},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationReject, pubsub.ValidationAccept},
+		Batch:           true,
+	}
+}
+
+// BatchDuplicateMessage tests a batch with the same message twice, its signature is verified once and the duplicate is
+// validated against the signer's state as if received after the first
+func BatchDuplicateMessage() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1))
+	return &MsgValidationTest{
+		Name:            "batch duplicate message",
+		Messages:        fromPeer(msg, msg),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{"", validation.ErrTooManyMessagesPerRound.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationReject},
+		Batch:           true,
+	}
+}
+
+// BatchMalformedMessage tests a batch with a malformed message, rejected before its signature is verified
+func BatchMalformedMessage() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name: "batch malformed message",
+		Messages: fromPeer(
+			[]byte{1, 2, 3, 4},
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)),
+		),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrMalformedMessage.Error(), ""},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject, pubsub.ValidationAccept},
+		Batch:           true,
+	}
+}
@@ -0,0 +1,118 @@
+package msgvalidation
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/ssvlabs/ssv-spec/p2p/validation"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// NoConsensusForRole tests a qbft message for a role that doesn't run consensus
+func NoConsensusForRole() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)
+
+	return &MsgValidationTest{
+		Name:            "no consensus for role",
+		Messages:        fromPeer(encodedMsg(ks, testingutils.SSVMsgValidatorRegistration(msg, nil))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrNoConsensusForRole.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// MalformedConsensusMessage tests a consensus SSVMessage with data that can't be decoded into a qbft message
+func MalformedConsensusMessage() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := &types.SSVMessage{
+		MsgType: types.SSVConsensusMsgType,
+		MsgID:   types.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingValidatorPubKey[:], types.BNRoleAttester),
+		Data:    []byte{1, 2, 3, 4},
+	}
+
+	return &MsgValidationTest{
+		Name:            "malformed consensus message",
+		Messages:        fromPeer(encodeSigned(testingutils.SignedSSVMessageWithSigner(1, ks.OperatorKeys[1], msg))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrMalformedMessage.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// IdentifierMismatch tests a qbft message with an identifier different than the SSVMessage's ID
+func IdentifierMismatch() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.TestingPrepareMessageWithParams(ks.Shares[1], 1, qbft.FirstRound, testingutils.TestingDutySlot, testingutils.TestingIdentifier, testingutils.TestingQBFTRootData)
+
+	return &MsgValidationTest{
+		Name:            "identifier mismatch",
+		Messages:        fromPeer(encodedQBFTMsg(ks, msg)),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrIdentifierMismatch.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// NonDecidedWithMultipleSigners tests a non commit qbft message with multiple signers
+func NonDecidedWithMultipleSigners() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name:            "non decided with multiple signers",
+		Messages:        fromPeer(encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1, 2, 3))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrNonDecidedWithMultipleSigners.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// DecidedNotEnoughSigners tests a commit message with multiple signers that are less than quorum
+func DecidedNotEnoughSigners() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name:            "decided not enough signers",
+		Messages:        fromPeer(encodedQBFTMsg(ks, qbftMsg(ks, qbft.CommitMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1, 2))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrDecidedNotEnoughSigners.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// InvalidFullData tests a qbft message with full data not matching its root
+func InvalidFullData() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := qbftMsg(ks, qbft.ProposalMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)
+	msg.FullData = testingutils.DifferentFullData
+
+	return &MsgValidationTest{
+		Name:            "invalid full data",
+		Messages:        fromPeer(encodedQBFTMsg(ks, msg)),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrInvalidFullData.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// InvalidQBFTSignature tests a qbft message with an invalid BLS signature
+func InvalidQBFTSignature() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)
+	msg.Signature = qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 2).Signature
+
+	return &MsgValidationTest{
+		Name:            "invalid qbft signature",
+		Messages:        fromPeer(encodedQBFTMsg(ks, msg)),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrInvalidQBFTSignature.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
@@ -0,0 +1,128 @@
+package msgvalidation
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/ssvlabs/ssv-spec/p2p/validation"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// TooManyMessagesPerRound tests 2 different messages of the same type and round from the same signer
+func TooManyMessagesPerRound() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	differentRoot := testingutils.TestingPrepareMessageWithParams(ks.Shares[1], 1, qbft.FirstRound, testingutils.TestingDutySlot, testingutils.AttesterMsgID, testingutils.DifferentRoot)
+	differentRoot.FullData = nil
+
+	return &MsgValidationTest{
+		Name: "too many messages per round",
+		Messages: fromPeer(
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)),
+			encodedQBFTMsg(ks, differentRoot),
+		),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{"", validation.ErrTooManyMessagesPerRound.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationReject},
+	}
+}
+
+// MessagesPerRoundDifferentTypes tests messages of different types in the same round from the same signer
+func MessagesPerRoundDifferentTypes() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name: "messages per round different types",
+		Messages: fromPeer(
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.ProposalMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)),
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)),
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.CommitMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)),
+		),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{"", "", ""},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationAccept, pubsub.ValidationAccept},
+	}
+}
+
+// RoundAlreadyAdvanced tests a message for a round lower than a round the signer already sent a message for
+func RoundAlreadyAdvanced() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name: "round already advanced",
+		Messages: fromPeer(
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.RoundChangeMsgType, 2, testingutils.TestingDutySlot, 1)),
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)),
+		),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        3 * time.Second,
+		ExpectedErrors:  []string{"", validation.ErrRoundAlreadyAdvanced.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationIgnore},
+	}
+}
+
+// SlotAlreadyAdvanced tests a message for a slot lower than a slot the signer already sent a message for
+func SlotAlreadyAdvanced() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name: "slot already advanced",
+		Messages: fromPeer(
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot+1, 1)),
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)),
+		),
+		Slot:            testingutils.TestingDutySlot + 1,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{"", validation.ErrSlotAlreadyAdvanced.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationIgnore},
+	}
+}
+
+// DifferentPeers tests the same message relayed by 2 peers, counters are kept per peer
+func DifferentPeers() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1))
+
+	return &MsgValidationTest{
+		Name: "different peers",
+		Messages: []*TestMessage{
+			{Peer: "peer1", Data: msg},
+			{Peer: "peer2", Data: msg},
+		},
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{"", ""},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationAccept},
+	}
+}
+
+// DecidedWithSameSigners tests 2 decided messages with the same signers
+func DecidedWithSameSigners() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	decided := encodedQBFTMsg(ks, qbftMsg(ks, qbft.CommitMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1, 2, 3))
+
+	return &MsgValidationTest{
+		Name:            "decided with same signers",
+		Messages:        fromPeer(decided, decided),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{"", validation.ErrDecidedWithSameSigners.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationIgnore},
+	}
+}
+
+// TooManyDecidedMessages tests more decided messages than possible signer combinations growing from quorum to the full committee
+func TooManyDecidedMessages() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name: "too many decided messages",
+		Messages: fromPeer(
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.CommitMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1, 2, 3)),
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.CommitMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1, 2, 3, 4)),
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.CommitMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1, 2, 4)),
+		),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{"", "", validation.ErrTooManyDecidedMessages.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationAccept, pubsub.ValidationReject},
+	}
+}
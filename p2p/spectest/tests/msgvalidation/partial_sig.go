@@ -0,0 +1,54 @@
+package msgvalidation
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/ssvlabs/ssv-spec/p2p/validation"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// PartialSigTypeMismatch tests a partial signature message type not used by the role
+func PartialSigTypeMismatch() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name:            "partial signature type mismatch",
+		Messages:        fromPeer(encodedMsg(ks, testingutils.SSVMsgAttester(nil, testingutils.PreConsensusRandaoMsg(ks.Shares[1], 1)))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrPartialSigTypeMismatch.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// TooManyPartialSigs tests 2 different partial signature messages of the same type and slot from the same signer
+func TooManyPartialSigs() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name: "too many partial signatures",
+		Messages: fromPeer(
+			encodedMsg(ks, testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, testingutils.TestingDutySlot))),
+			encodedMsg(ks, testingutils.SSVMsgAttester(nil, testingutils.PostConsensusWrongAttestationMsg(ks.Shares[1], 1, testingutils.TestingDutySlot))),
+		),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        4 * time.Second,
+		ExpectedErrors:  []string{"", validation.ErrTooManyPartialSigs.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept, pubsub.ValidationReject},
+	}
+}
+
+// InvalidPartialSignature tests a partial signature message with an invalid signature
+func InvalidPartialSignature() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, testingutils.TestingDutySlot)
+	msg.Signature = testingutils.PostConsensusAttestationMsg(ks.Shares[2], 2, testingutils.TestingDutySlot).Signature
+
+	return &MsgValidationTest{
+		Name:            "invalid partial signature",
+		Messages:        fromPeer(encodedMsg(ks, testingutils.SSVMsgAttester(nil, msg))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        4 * time.Second,
+		ExpectedErrors:  []string{validation.ErrInvalidPartialSignature.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
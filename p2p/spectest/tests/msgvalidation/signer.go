@@ -0,0 +1,72 @@
+package msgvalidation
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/ssvlabs/ssv-spec/p2p/validation"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// SignerNotInCommittee tests a message signed by an operator outside the validator's committee
+func SignerNotInCommittee() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	signed := testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1), nil))
+	signed.OperatorID = 5
+
+	return &MsgValidationTest{
+		Name:            "signer not in committee",
+		Messages:        fromPeer(encodeSigned(signed)),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrSignerNotInCommittee.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// InvalidSignature tests a message with an invalid operator (RSA) signature
+func InvalidSignature() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	signed := testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1), nil))
+	signed.Signature = testingutils.TestingSignedSSVMessageSignature
+
+	return &MsgValidationTest{
+		Name:            "invalid signature",
+		Messages:        fromPeer(encodeSigned(signed)),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrInvalidSignature.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// InconsistentConsensusSigner tests a qbft message signed by an operator other than the SignedSSVMessage's signer
+func InconsistentConsensusSigner() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.SSVMsgAttester(qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 2), nil)
+
+	return &MsgValidationTest{
+		Name:            "inconsistent consensus signer",
+		Messages:        fromPeer(encodeSigned(testingutils.SignedSSVMessageWithSigner(1, ks.OperatorKeys[1], msg))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrInconsistentSigner.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// InconsistentPartialSigSigner tests a partial signature message signed by an operator other than the SignedSSVMessage's signer
+func InconsistentPartialSigSigner() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[2], 2, testingutils.TestingDutySlot))
+
+	return &MsgValidationTest{
+		Name:            "inconsistent partial signature signer",
+		Messages:        fromPeer(encodeSigned(testingutils.SignedSSVMessageWithSigner(1, ks.OperatorKeys[1], msg))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        4 * time.Second,
+		ExpectedErrors:  []string{validation.ErrInconsistentSigner.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
@@ -0,0 +1,100 @@
+package msgvalidation
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/ssvlabs/ssv-spec/p2p/validation"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// EmptyData tests a message with no data
+func EmptyData() *MsgValidationTest {
+	return &MsgValidationTest{
+		Name:            "empty data",
+		Messages:        fromPeer([]byte{}),
+		Slot:            testingutils.TestingDutySlot,
+		ExpectedErrors:  []string{validation.ErrEmptyData.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// MalformedMessage tests data that can't be decoded into a SignedSSVMessage
+func MalformedMessage() *MsgValidationTest {
+	return &MsgValidationTest{
+		Name:            "malformed message",
+		Messages:        fromPeer([]byte{1, 2, 3, 4}),
+		Slot:            testingutils.TestingDutySlot,
+		ExpectedErrors:  []string{validation.ErrMalformedMessage.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// WrongDomain tests a message for another domain
+func WrongDomain() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.SSVMsgAttester(qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1), nil)
+	msg.MsgID = types.NewMsgID(types.DomainType{0x99, 0x99, 0x99, 0x99}, testingutils.TestingValidatorPubKey[:], types.BNRoleAttester)
+
+	return &MsgValidationTest{
+		Name:            "wrong domain",
+		Messages:        fromPeer(encodedMsg(ks, msg)),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrWrongDomain.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// UnknownRole tests a message with an unknown role
+func UnknownRole() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.SSVMsgAttester(qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1), nil)
+	msg.MsgID = types.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingValidatorPubKey[:], testingutils.UnknownDutyType)
+
+	return &MsgValidationTest{
+		Name:            "unknown role",
+		Messages:        fromPeer(encodedMsg(ks, msg)),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrUnknownRole.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
+
+// UnknownValidator tests a message for a validator the node doesn't know (yet), ignored as the node might lag behind
+func UnknownValidator() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.SSVMsgAttester(qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1), nil)
+	msg.MsgID = types.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingWrongValidatorPubKey[:], types.BNRoleAttester)
+
+	return &MsgValidationTest{
+		Name:            "unknown validator",
+		Messages:        fromPeer(encodedMsg(ks, msg)),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrUnknownValidator.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationIgnore},
+	}
+}
+
+// UnknownMessageType tests a message with an unknown SSVMessage type
+func UnknownMessageType() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := &types.SSVMessage{
+		MsgType: types.MsgType(100),
+		MsgID:   types.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingValidatorPubKey[:], types.BNRoleAttester),
+		Data:    []byte{1, 2, 3, 4},
+	}
+
+	return &MsgValidationTest{
+		Name:            "unknown message type",
+		Messages:        fromPeer(encodeSigned(testingutils.SignedSSVMessageWithSigner(1, ks.OperatorKeys[1], msg))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrUnknownMessageType.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationReject},
+	}
+}
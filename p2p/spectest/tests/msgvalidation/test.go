@@ -0,0 +1,88 @@
+package msgvalidation
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/ssvlabs/ssv-spec/p2p/validation"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMessage is an encoded SignedSSVMessage received from a peer
+type TestMessage struct {
+	// Peer is the relaying peer's ID, a plain string as peer.ID's json encoding requires a valid multihash
+	Peer string
+	Data []byte
+}
+
+// MsgValidationTest validates the messages, in order, at the given time using a validator for testingutils.Testing4SharesSet's share
+type MsgValidationTest struct {
+	Name     string
+	Messages []*TestMessage
+	// Slot and SlotTime are the slot and the time since its start at which the messages are received
+	Slot     phase0.Slot
+	SlotTime time.Duration
+	// ExpectedErrors holds the expected error for each message, empty if accepted
+	ExpectedErrors  []string
+	ExpectedResults []pubsub.ValidationResult
+	// Batch validates the messages at once (MessageValidator.ValidateMessages) with types.OperatorSignatureVerifier,
+	// all the messages must be relayed by the same peer
+	Batch bool `json:",omitempty"`
+}
+
+func (test *MsgValidationTest) TestName() string {
+	return "msg validation " + test.Name
+}
+
+func (test *MsgValidationTest) Run(t *testing.T) {
+	require.Len(t, test.ExpectedErrors, len(test.Messages))
+	require.Len(t, test.ExpectedResults, len(test.Messages))
+
+	share := testingutils.TestingShare(testingutils.Testing4SharesSet())
+	verifier := testingutils.NewTestingVerifier()
+	if test.Batch {
+		verifier = types.NewOperatorSignatureVerifier(0, 0, nil)
+	}
+	mv := validation.NewMessageValidator(
+		testingutils.TestingSSVDomainType,
+		types.BeaconTestNetwork,
+		verifier,
+		func(validatorPK []byte) *types.Share {
+			if bytes.Equal(validatorPK, share.ValidatorPubKey) {
+				return share
+			}
+			return nil
+		},
+	)
+
+	receivedAt := time.Unix(types.BeaconTestNetwork.EstimatedTimeAtSlot(test.Slot), 0).Add(test.SlotTime)
+	errs := make([]error, len(test.Messages))
+	if test.Batch {
+		data := make([][]byte, 0, len(test.Messages))
+		for _, msg := range test.Messages {
+			require.Equal(t, test.Messages[0].Peer, msg.Peer)
+			data = append(data, msg.Data)
+		}
+		errs = mv.ValidateMessages(peer.ID(test.Messages[0].Peer), data, receivedAt)
+	} else {
+		for i, msg := range test.Messages {
+			errs[i] = mv.ValidateMessage(peer.ID(msg.Peer), msg.Data, receivedAt)
+		}
+	}
+
+	for i, err := range errs {
+		if len(test.ExpectedErrors[i]) > 0 {
+			require.Error(t, err, "message %d", i)
+			require.Contains(t, err.Error(), test.ExpectedErrors[i], "message %d", i)
+		} else {
+			require.NoError(t, err, "message %d", i)
+		}
+		require.Equal(t, test.ExpectedResults[i], validation.ValidationResultForError(err), "message %d", i)
+	}
+}
@@ -0,0 +1,69 @@
+package msgvalidation
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/ssvlabs/ssv-spec/p2p/validation"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// EarlyMessage tests a message for a slot that didn't start yet
+func EarlyMessage() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name:            "early message",
+		Messages:        fromPeer(encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot+1, 1))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrEarlyMessage.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationIgnore},
+	}
+}
+
+// LateMessage tests an attester message received after the attester late slot allowance (an epoch and 2 slots)
+func LateMessage() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name: "late message",
+		Messages: fromPeer(
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)),
+		),
+		Slot:            testingutils.TestingDutySlot + 32 + validation.LateSlotAllowance + 1,
+		ExpectedErrors:  []string{validation.ErrLateMessage.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationIgnore},
+	}
+}
+
+// LateProposerMessage tests a proposer message received after the late slot allowance
+func LateProposerMessage() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1)
+	msg.Message.Identifier = testingutils.ProposerMsgID
+	msg.Signature = testingutils.SignQBFTMsg(ks.Shares[1], 1, &msg.Message).Signature
+
+	return &MsgValidationTest{
+		Name:            "late proposer message",
+		Messages:        fromPeer(encodedMsg(ks, testingutils.SSVMsgProposer(msg, nil))),
+		Slot:            testingutils.TestingDutySlot + validation.LateSlotAllowance + 1,
+		ExpectedErrors:  []string{validation.ErrLateMessage.Error()},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationIgnore},
+	}
+}
+
+// RoundTooHigh tests a round that can't be reached at the time the message is received
+func RoundTooHigh() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name: "round too high",
+		Messages: fromPeer(
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.RoundChangeMsgType, 3, testingutils.TestingDutySlot, 1)),
+			encodedQBFTMsg(ks, qbftMsg(ks, qbft.RoundChangeMsgType, 2, testingutils.TestingDutySlot, 1)),
+		),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{validation.ErrRoundTooHigh.Error(), ""},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationIgnore, pubsub.ValidationAccept},
+	}
+}
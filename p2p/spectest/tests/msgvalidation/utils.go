@@ -0,0 +1,54 @@
+package msgvalidation
+
+import (
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// testingPeer is the peer relaying the test messages
+const testingPeer = "peer1"
+
+// qbftMsg returns an attester qbft message for the testing duty slot signed by the given operators
+func qbftMsg(ks *testingutils.TestKeySet, msgType qbft.MessageType, round qbft.Round, height qbft.Height, ids ...types.OperatorID) *qbft.SignedMessage {
+	sks := make([]*bls.SecretKey, 0)
+	for _, id := range ids {
+		sks = append(sks, ks.Shares[id])
+	}
+	msg := &qbft.Message{
+		MsgType:    msgType,
+		Height:     height,
+		Round:      round,
+		Identifier: testingutils.AttesterMsgID,
+		Root:       testingutils.TestingQBFTRootData,
+	}
+	ret := testingutils.MultiSignQBFTMsg(sks, ids, msg)
+	ret.FullData = testingutils.TestingQBFTFullData
+	return ret
+}
+
+// encodedMsg returns the encoded SignedSSVMessage for the SSVMessage signed by its inner message's signer
+func encodedMsg(ks *testingutils.TestKeySet, ssvMsg *types.SSVMessage) []byte {
+	return encodeSigned(testingutils.SignedSSVMessageF(ks, ssvMsg))
+}
+
+func encodeSigned(signedSSVMsg *types.SignedSSVMessage) []byte {
+	byts, err := signedSSVMsg.Encode()
+	if err != nil {
+		panic(err.Error())
+	}
+	return byts
+}
+
+func encodedQBFTMsg(ks *testingutils.TestKeySet, msg *qbft.SignedMessage) []byte {
+	return encodedMsg(ks, testingutils.SSVMsgAttester(msg, nil))
+}
+
+func fromPeer(data ...[]byte) []*TestMessage {
+	ret := make([]*TestMessage, 0)
+	for _, d := range data {
+		ret = append(ret, &TestMessage{Peer: testingPeer, Data: d})
+	}
+	return ret
+}
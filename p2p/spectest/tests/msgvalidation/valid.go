@@ -0,0 +1,49 @@
+package msgvalidation
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// ValidConsensus tests a valid qbft message
+func ValidConsensus() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name:            "valid consensus",
+		Messages:        fromPeer(encodedQBFTMsg(ks, qbftMsg(ks, qbft.PrepareMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{""},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept},
+	}
+}
+
+// ValidDecided tests a valid decided message
+func ValidDecided() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name:            "valid decided",
+		Messages:        fromPeer(encodedQBFTMsg(ks, qbftMsg(ks, qbft.CommitMsgType, qbft.FirstRound, testingutils.TestingDutySlot, 1, 2, 3))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        time.Second,
+		ExpectedErrors:  []string{""},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept},
+	}
+}
+
+// ValidPartialSig tests a valid partial signature message
+func ValidPartialSig() *MsgValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &MsgValidationTest{
+		Name: "valid partial signature",
+		Messages: fromPeer(encodedMsg(ks, testingutils.SSVMsgAttester(nil,
+			testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, testingutils.TestingDutySlot)))),
+		Slot:            testingutils.TestingDutySlot,
+		SlotTime:        4 * time.Second,
+		ExpectedErrors:  []string{""},
+		ExpectedResults: []pubsub.ValidationResult{pubsub.ValidationAccept},
+	}
+}
@@ -0,0 +1,84 @@
+package peers
+
+import (
+	"github.com/ssvlabs/ssv-spec/p2p/peers"
+)
+
+func balancingConfig() *peers.BalancingConfig {
+	return &peers.BalancingConfig{
+		MaxPeers:        4,
+		MaxInboundRatio: 1,
+		BadPeerScore:    -4000,
+		SubnetsCount:    128,
+	}
+}
+
+// BelowPeersLimit tests no peer is pruned while the node is below the peers limit
+func BelowPeersLimit() *BalancingSpecTest {
+	return &BalancingSpecTest{
+		Name:         "below peers limit",
+		Config:       balancingConfig(),
+		LocalSubnets: []uint64{1, 2},
+		Peers: []*Peer{
+			{PeerID: "peer-a", Direction: peers.Outbound, Subnets: []uint64{1}},
+			{PeerID: "peer-b", Direction: peers.Inbound, Subnets: []uint64{3}},
+			{PeerID: "peer-c", Direction: peers.Inbound, Subnets: []uint64{2}},
+		},
+		ExpectedProtect:    []string{},
+		ExpectedDisconnect: []string{},
+	}
+}
+
+// BadPeers tests peers with bad behaviour are disconnected regardless of the peers limit
+func BadPeers() *BalancingSpecTest {
+	return &BalancingSpecTest{
+		Name:         "bad peers",
+		Config:       balancingConfig(),
+		LocalSubnets: []uint64{1, 2},
+		Peers: []*Peer{
+			{PeerID: "peer-a", Direction: peers.Outbound, Subnets: []uint64{1}},
+			{PeerID: "peer-b", Direction: peers.Inbound, Subnets: []uint64{1, 2}, Score: -5000},
+		},
+		ExpectedProtect:    []string{},
+		ExpectedDisconnect: []string{"peer-b"},
+	}
+}
+
+// TrimPeers tests the peers covering the subnets lacking peers are protected once reaching the peers limit
+func TrimPeers() *BalancingSpecTest {
+	return &BalancingSpecTest{
+		Name:         "trim peers",
+		Config:       balancingConfig(),
+		LocalSubnets: []uint64{1, 2},
+		Peers: []*Peer{
+			{PeerID: "peer-a", Direction: peers.Inbound, Subnets: []uint64{1}},
+			{PeerID: "peer-b", Direction: peers.Inbound, Subnets: []uint64{1}},
+			{PeerID: "peer-c", Direction: peers.Outbound, Subnets: []uint64{1}},
+			{PeerID: "peer-d", Direction: peers.Inbound, Subnets: []uint64{2}},
+			{PeerID: "peer-e", Direction: peers.Outbound, Subnets: []uint64{3}},
+		},
+		ExpectedProtect:    []string{"peer-a", "peer-b", "peer-d"},
+		ExpectedDisconnect: []string{"peer-c", "peer-e"},
+	}
+}
+
+// InboundRatio tests the number of protected inbound peers is limited
+func InboundRatio() *BalancingSpecTest {
+	config := balancingConfig()
+	config.MaxInboundRatio = 0.5
+
+	return &BalancingSpecTest{
+		Name:         "inbound ratio",
+		Config:       config,
+		LocalSubnets: []uint64{1, 2},
+		Peers: []*Peer{
+			{PeerID: "peer-a", Direction: peers.Inbound, Subnets: []uint64{1}},
+			{PeerID: "peer-b", Direction: peers.Inbound, Subnets: []uint64{1}},
+			{PeerID: "peer-c", Direction: peers.Outbound, Subnets: []uint64{1}},
+			{PeerID: "peer-d", Direction: peers.Inbound, Subnets: []uint64{2}},
+			{PeerID: "peer-e", Direction: peers.Outbound, Subnets: []uint64{3}},
+		},
+		ExpectedProtect:    []string{"peer-c", "peer-d", "peer-e"},
+		ExpectedDisconnect: []string{"peer-a", "peer-b"},
+	}
+}
@@ -0,0 +1,55 @@
+package peers
+
+import (
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/p2p/discovery"
+	"github.com/ssvlabs/ssv-spec/p2p/peers"
+	"github.com/stretchr/testify/require"
+)
+
+// Peer is a connected peer
+type Peer struct {
+	PeerID    string
+	Direction peers.Direction
+	Subnets   []uint64
+	Score     float64
+}
+
+// BalancingSpecTest runs the peers balancing procedure over the connected peers
+type BalancingSpecTest struct {
+	Name               string
+	Config             *peers.BalancingConfig
+	LocalSubnets       []uint64
+	Peers              []*Peer
+	ExpectedProtect    []string
+	ExpectedDisconnect []string
+}
+
+func (test *BalancingSpecTest) TestName() string {
+	return "balancing " + test.Name
+}
+
+func (test *BalancingSpecTest) Run(t *testing.T) {
+	infos := make([]*peers.PeerInfo, 0, len(test.Peers))
+	for _, p := range test.Peers {
+		infos = append(infos, &peers.PeerInfo{
+			PeerID:    p.PeerID,
+			Direction: p.Direction,
+			Subnets:   subnetsOf(t, test.Config.SubnetsCount, p.Subnets),
+			Score:     p.Score,
+		})
+	}
+
+	result := peers.Balance(test.Config, subnetsOf(t, test.Config.SubnetsCount, test.LocalSubnets), infos)
+	require.EqualValues(t, test.ExpectedProtect, result.Protect)
+	require.EqualValues(t, test.ExpectedDisconnect, result.Disconnect)
+}
+
+func subnetsOf(t *testing.T, subnetsCount uint64, active []uint64) discovery.Subnets {
+	ret := discovery.NewSubnets(subnetsCount)
+	for _, subnet := range active {
+		require.NoError(t, ret.Set(subnet))
+	}
+	return ret
+}
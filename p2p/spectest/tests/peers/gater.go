@@ -0,0 +1,50 @@
+package peers
+
+// Blocklist tests blocked peers are refused until unblocked
+func Blocklist() *GaterSpecTest {
+	return &GaterSpecTest{
+		Name:              "blocklist",
+		IPColocationLimit: 10,
+		Steps: []*GaterStep{
+			{Action: Block, Peer: "peer-a"},
+			{Action: Dial, Peer: "peer-a", Expected: false},
+			{Action: Secured, Peer: "peer-a", Addr: "/ip4/1.2.3.4/tcp/12001", Expected: false},
+			{Action: Dial, Peer: "peer-b", Expected: true},
+			{Action: Secured, Peer: "peer-b", Addr: "/ip4/1.2.3.4/tcp/12001", Expected: true},
+			{Action: Unblock, Peer: "peer-a"},
+			{Action: Dial, Peer: "peer-a", Expected: true},
+		},
+	}
+}
+
+// IPColocation tests inbound connections are refused once the IP reached the colocation limit
+func IPColocation() *GaterSpecTest {
+	return &GaterSpecTest{
+		Name:              "ip colocation",
+		IPColocationLimit: 2,
+		Steps: []*GaterStep{
+			{Action: Connect, Peer: "peer-a", Addr: "/ip4/1.2.3.4/tcp/12001"},
+			{Action: Accept, Addr: "/ip4/1.2.3.4/tcp/12002", Expected: true},
+			{Action: Connect, Peer: "peer-b", Addr: "/ip4/1.2.3.4/tcp/12002"},
+			{Action: Accept, Addr: "/ip4/1.2.3.4/tcp/12003", Expected: false},
+			{Action: Accept, Addr: "/ip4/5.6.7.8/tcp/12001", Expected: true},
+			{Action: Disconnect, Peer: "peer-a"},
+			{Action: Accept, Addr: "/ip4/1.2.3.4/tcp/12003", Expected: true},
+		},
+	}
+}
+
+// IPWhitelist tests whitelisted IPs are not limited
+func IPWhitelist() *GaterSpecTest {
+	return &GaterSpecTest{
+		Name:              "ip whitelist",
+		IPColocationLimit: 1,
+		IPWhitelist:       []string{"10.0.0.0/8"},
+		Steps: []*GaterStep{
+			{Action: Connect, Peer: "peer-a", Addr: "/ip4/10.0.0.1/tcp/12001"},
+			{Action: Accept, Addr: "/ip4/10.0.0.1/tcp/12002", Expected: true},
+			{Action: Connect, Peer: "peer-b", Addr: "/ip4/1.2.3.4/tcp/12001"},
+			{Action: Accept, Addr: "/ip4/1.2.3.4/tcp/12002", Expected: false},
+		},
+	}
+}
@@ -0,0 +1,86 @@
+package peers
+
+import (
+	"net"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/ssvlabs/ssv-spec/p2p/peers"
+	"github.com/stretchr/testify/require"
+)
+
+// Gater actions
+const (
+	Block      = "block"
+	Unblock    = "unblock"
+	Connect    = "connect"
+	Disconnect = "disconnect"
+	Dial       = "dial"
+	Accept     = "accept"
+	Secured    = "secured"
+)
+
+// GaterStep is an action on the connection gater, Expected is the result of interception actions (dial, accept and secured)
+type GaterStep struct {
+	Action   string
+	Peer     string
+	Addr     string
+	Expected bool
+}
+
+// GaterSpecTest runs a sequence of steps on a connection gater
+type GaterSpecTest struct {
+	Name              string
+	IPColocationLimit int
+	IPWhitelist       []string
+	Steps             []*GaterStep
+}
+
+func (test *GaterSpecTest) TestName() string {
+	return "connection gater " + test.Name
+}
+
+func (test *GaterSpecTest) Run(t *testing.T) {
+	whitelist := make([]*net.IPNet, 0, len(test.IPWhitelist))
+	for _, cidr := range test.IPWhitelist {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		require.NoError(t, err)
+		whitelist = append(whitelist, ipNet)
+	}
+	gater := peers.NewConnectionGater(test.IPColocationLimit, whitelist...)
+
+	for i, step := range test.Steps {
+		p := peer.ID(step.Peer)
+		switch step.Action {
+		case Block:
+			gater.Block(p)
+		case Unblock:
+			gater.Unblock(p)
+		case Connect:
+			gater.Connected(p, ma.StringCast(step.Addr))
+		case Disconnect:
+			gater.Disconnected(p)
+		case Dial:
+			require.EqualValues(t, step.Expected, gater.InterceptPeerDial(p), "step %d", i)
+		case Accept:
+			require.EqualValues(t, step.Expected, gater.InterceptAccept(connAddrs(step.Addr)), "step %d", i)
+		case Secured:
+			require.EqualValues(t, step.Expected, gater.InterceptSecured(network.DirInbound, p, connAddrs(step.Addr)), "step %d", i)
+		default:
+			t.Fatalf("unknown action %s", step.Action)
+		}
+	}
+}
+
+// connAddrs implements network.ConnMultiaddrs for a remote address
+type connAddrs string
+
+func (c connAddrs) LocalMultiaddr() ma.Multiaddr {
+	return ma.StringCast("/ip4/127.0.0.1/tcp/12001")
+}
+
+func (c connAddrs) RemoteMultiaddr() ma.Multiaddr {
+	return ma.StringCast(string(c))
+}
@@ -0,0 +1,199 @@
+package scoring
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+)
+
+const (
+	oneEpoch      = 384 * time.Second
+	msgIDCacheTTL = 385 * time.Second
+	subnets       = 128
+)
+
+var expectedThresholds = &pubsub.PeerScoreThresholds{
+	GossipThreshold:             -4000,
+	PublishThreshold:            -8000,
+	GraylistThreshold:           -16000,
+	AcceptPXThreshold:           100,
+	OpportunisticGraftThreshold: 5,
+}
+
+var expectedPeerScoreParams = &PeerScoreParams{
+	TopicScoreCap:               56.25,
+	AppSpecificWeight:           1,
+	IPColocationFactorWeight:    -56.25,
+	IPColocationFactorThreshold: 10,
+	BehaviourPenaltyWeight:      -13.68400186349414,
+	BehaviourPenaltyThreshold:   10,
+	BehaviourPenaltyDecay:       0.6309573444801931,
+	DecayInterval:               oneEpoch,
+	DecayToZero:                 0.01,
+	RetainScore:                 10 * oneEpoch,
+	SeenMsgTTL:                  msgIDCacheTTL,
+}
+
+// Validators1K tests the documented params of a network with 1k validators
+func Validators1K() *ScoringSpecTest {
+	return &ScoringSpecTest{
+		Name:                    "1k validators",
+		ActiveValidators:        1000,
+		Subnets:                 subnets,
+		OneEpochDuration:        oneEpoch,
+		MsgIDCacheTTL:           msgIDCacheTTL,
+		ExpectedThresholds:      expectedThresholds,
+		ExpectedPeerScoreParams: expectedPeerScoreParams,
+		ExpectedDecidedParams: &pubsub.TopicScoreParams{
+			TopicWeight:                     0.5,
+			TimeInMeshWeight:                0.03333333333333333,
+			TimeInMeshQuantum:               12 * time.Second,
+			TimeInMeshCap:                   300,
+			FirstMessageDeliveriesWeight:    5.0687999999999995,
+			FirstMessageDeliveriesDecay:     0.01,
+			FirstMessageDeliveriesCap:       7.891414141414142,
+			MeshMessageDeliveriesWeight:     -0.12514111392630922,
+			MeshMessageDeliveriesDecay:      0.7498942093324558,
+			MeshMessageDeliveriesCap:        59.96616130565809,
+			MeshMessageDeliveriesThreshold:  1.8739425408018153,
+			MeshMessageDeliveriesWindow:     2 * time.Second,
+			MeshMessageDeliveriesActivation: oneEpoch,
+			MeshFailurePenaltyWeight:        -0.12514111392630922,
+			MeshFailurePenaltyDecay:         0.7498942093324558,
+			InvalidMessageDeliveriesWeight:  0,
+			InvalidMessageDeliveriesDecay:   0.1,
+		},
+		ExpectedSubnetParams: &pubsub.TopicScoreParams{
+			TopicWeight:                     0.03125,
+			TimeInMeshWeight:                0.03333333333333333,
+			TimeInMeshQuantum:               12 * time.Second,
+			TimeInMeshCap:                   300,
+			FirstMessageDeliveriesWeight:    31.869332124805872,
+			FirstMessageDeliveriesDecay:     0.5623413251903491,
+			FirstMessageDeliveriesCap:       1.255125141730395,
+			MeshMessageDeliveriesWeight:     -450,
+			MeshMessageDeliveriesDecay:      0.7498942093324558,
+			MeshMessageDeliveriesCap:        2.1081853584020425,
+			MeshMessageDeliveriesThreshold:  0.13176158490012765,
+			MeshMessageDeliveriesWindow:     2 * time.Second,
+			MeshMessageDeliveriesActivation: oneEpoch,
+			MeshFailurePenaltyWeight:        -450,
+			MeshFailurePenaltyDecay:         0.7498942093324558,
+			InvalidMessageDeliveriesWeight:  0,
+			InvalidMessageDeliveriesDecay:   0.1,
+		},
+	}
+}
+
+// Validators10K tests the documented params of a network with 10k validators
+func Validators10K() *ScoringSpecTest {
+	return &ScoringSpecTest{
+		Name:                    "10k validators",
+		ActiveValidators:        10000,
+		Subnets:                 subnets,
+		OneEpochDuration:        oneEpoch,
+		MsgIDCacheTTL:           msgIDCacheTTL,
+		ExpectedThresholds:      expectedThresholds,
+		ExpectedPeerScoreParams: expectedPeerScoreParams,
+		ExpectedDecidedParams: &pubsub.TopicScoreParams{
+			TopicWeight:                     0.5,
+			TimeInMeshWeight:                0.03333333333333333,
+			TimeInMeshQuantum:               12 * time.Second,
+			TimeInMeshCap:                   300,
+			FirstMessageDeliveriesWeight:    0.50688,
+			FirstMessageDeliveriesDecay:     0.01,
+			FirstMessageDeliveriesCap:       78.91414141414141,
+			MeshMessageDeliveriesWeight:     -0.01222081190686613,
+			MeshMessageDeliveriesDecay:      0.7498942093324558,
+			MeshMessageDeliveriesCap:        191.89171617810592,
+			MeshMessageDeliveriesThreshold:  5.99661613056581,
+			MeshMessageDeliveriesWindow:     2 * time.Second,
+			MeshMessageDeliveriesActivation: oneEpoch,
+			MeshFailurePenaltyWeight:        -0.01222081190686613,
+			MeshFailurePenaltyDecay:         0.7498942093324558,
+			InvalidMessageDeliveriesWeight:  0,
+			InvalidMessageDeliveriesDecay:   0.1,
+		},
+		ExpectedSubnetParams: &pubsub.TopicScoreParams{
+			TopicWeight:                     0.03125,
+			TimeInMeshWeight:                0.03333333333333333,
+			TimeInMeshQuantum:               12 * time.Second,
+			TimeInMeshCap:                   300,
+			FirstMessageDeliveriesWeight:    3.186933212480587,
+			FirstMessageDeliveriesDecay:     0.5623413251903491,
+			FirstMessageDeliveriesCap:       12.55125141730395,
+			MeshMessageDeliveriesWeight:     -16.199996132888096,
+			MeshMessageDeliveriesDecay:      0.7498942093324558,
+			MeshMessageDeliveriesCap:        21.081853584020426,
+			MeshMessageDeliveriesThreshold:  1.3176158490012766,
+			MeshMessageDeliveriesWindow:     2 * time.Second,
+			MeshMessageDeliveriesActivation: oneEpoch,
+			MeshFailurePenaltyWeight:        -16.199996132888096,
+			MeshFailurePenaltyDecay:         0.7498942093324558,
+			InvalidMessageDeliveriesWeight:  0,
+			InvalidMessageDeliveriesDecay:   0.1,
+		},
+	}
+}
+
+// Validators51K tests the documented params of a network with 51k validators
+func Validators51K() *ScoringSpecTest {
+	return &ScoringSpecTest{
+		Name:                    "51k validators",
+		ActiveValidators:        51000,
+		Subnets:                 subnets,
+		OneEpochDuration:        oneEpoch,
+		MsgIDCacheTTL:           msgIDCacheTTL,
+		ExpectedThresholds:      expectedThresholds,
+		ExpectedPeerScoreParams: expectedPeerScoreParams,
+		ExpectedDecidedParams: &pubsub.TopicScoreParams{
+			TopicWeight:                     0.5,
+			TimeInMeshWeight:                0.03333333333333333,
+			TimeInMeshQuantum:               12 * time.Second,
+			TimeInMeshCap:                   300,
+			FirstMessageDeliveriesWeight:    0.09938823529411765,
+			FirstMessageDeliveriesDecay:     0.01,
+			FirstMessageDeliveriesCap:       402.4621212121212,
+			MeshMessageDeliveriesWeight:     -0.01222081190686613,
+			MeshMessageDeliveriesDecay:      0.7498942093324558,
+			MeshMessageDeliveriesCap:        191.89171617810592,
+			MeshMessageDeliveriesThreshold:  5.99661613056581,
+			MeshMessageDeliveriesWindow:     2 * time.Second,
+			MeshMessageDeliveriesActivation: oneEpoch,
+			MeshFailurePenaltyWeight:        -0.01222081190686613,
+			MeshFailurePenaltyDecay:         0.7498942093324558,
+			InvalidMessageDeliveriesWeight:  0,
+			InvalidMessageDeliveriesDecay:   0.1,
+		},
+		ExpectedSubnetParams: &pubsub.TopicScoreParams{
+			TopicWeight:                     0.03125,
+			TimeInMeshWeight:                0.03333333333333333,
+			TimeInMeshQuantum:               12 * time.Second,
+			TimeInMeshCap:                   300,
+			FirstMessageDeliveriesWeight:    0.624888865192272,
+			FirstMessageDeliveriesDecay:     0.5623413251903491,
+			FirstMessageDeliveriesCap:       64.01138222825014,
+			MeshMessageDeliveriesWeight:     -0.7821319620394324,
+			MeshMessageDeliveriesDecay:      0.7498942093324558,
+			MeshMessageDeliveriesCap:        95.94585808905296,
+			MeshMessageDeliveriesThreshold:  5.99661613056581,
+			MeshMessageDeliveriesWindow:     2 * time.Second,
+			MeshMessageDeliveriesActivation: oneEpoch,
+			MeshFailurePenaltyWeight:        -0.7821319620394324,
+			MeshFailurePenaltyDecay:         0.7498942093324558,
+			InvalidMessageDeliveriesWeight:  0,
+			InvalidMessageDeliveriesDecay:   0.1,
+		},
+	}
+}
+
+// ZeroEpochDuration tests params can't be generated without an epoch duration
+func ZeroEpochDuration() *ScoringSpecTest {
+	return &ScoringSpecTest{
+		Name:             "zero epoch duration",
+		ActiveValidators: 1000,
+		Subnets:          subnets,
+		MsgIDCacheTTL:    msgIDCacheTTL,
+		ExpectedError:    "one epoch duration must be positive",
+	}
+}
@@ -0,0 +1,93 @@
+package scoring
+
+import (
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/ssvlabs/ssv-spec/p2p/scoring"
+	"github.com/stretchr/testify/require"
+)
+
+// maxBehaviourPenaltiesPerEpoch is the behaviour penalties rate documented to bring a peer to the gossip threshold
+const maxBehaviourPenaltiesPerEpoch = 10.0
+
+// PeerScoreParams are the expected general peer score params (pubsub.PeerScoreParams holds a func and can't be encoded)
+type PeerScoreParams struct {
+	TopicScoreCap               float64
+	AppSpecificWeight           float64
+	IPColocationFactorWeight    float64
+	IPColocationFactorThreshold int
+	BehaviourPenaltyWeight      float64
+	BehaviourPenaltyThreshold   float64
+	BehaviourPenaltyDecay       float64
+	DecayInterval               time.Duration
+	DecayToZero                 float64
+	RetainScore                 time.Duration
+	SeenMsgTTL                  time.Duration
+}
+
+// ScoringSpecTest tests the score params generated for the given network arguments against the values documented in SCORING.md
+type ScoringSpecTest struct {
+	Name                    string
+	ActiveValidators        int
+	Subnets                 int
+	OneEpochDuration        time.Duration
+	MsgIDCacheTTL           time.Duration
+	ExpectedThresholds      *pubsub.PeerScoreThresholds
+	ExpectedPeerScoreParams *PeerScoreParams
+	ExpectedDecidedParams   *pubsub.TopicScoreParams
+	ExpectedSubnetParams    *pubsub.TopicScoreParams
+	ExpectedError           string
+}
+
+func (test *ScoringSpecTest) TestName() string {
+	return "scoring " + test.Name
+}
+
+func (test *ScoringSpecTest) Run(t *testing.T) {
+	network := scoring.NewNetworkOpts(test.ActiveValidators, test.Subnets, test.OneEpochDuration)
+
+	peerParams, err := scoring.PeerScoreParams(network, scoring.PeerScoreOpts{
+		MsgIDCacheTTL:         test.MsgIDCacheTTL,
+		IPColocationThreshold: scoring.DefaultIPColocationThreshold,
+	})
+	if len(test.ExpectedError) > 0 {
+		require.EqualError(t, err, test.ExpectedError)
+		return
+	}
+	require.NoError(t, err)
+
+	require.EqualValues(t, test.ExpectedThresholds, scoring.PeerScoreThresholds())
+	require.EqualValues(t, test.ExpectedPeerScoreParams, &PeerScoreParams{
+		TopicScoreCap:               peerParams.TopicScoreCap,
+		AppSpecificWeight:           peerParams.AppSpecificWeight,
+		IPColocationFactorWeight:    peerParams.IPColocationFactorWeight,
+		IPColocationFactorThreshold: peerParams.IPColocationFactorThreshold,
+		BehaviourPenaltyWeight:      peerParams.BehaviourPenaltyWeight,
+		BehaviourPenaltyThreshold:   peerParams.BehaviourPenaltyThreshold,
+		BehaviourPenaltyDecay:       peerParams.BehaviourPenaltyDecay,
+		DecayInterval:               peerParams.DecayInterval,
+		DecayToZero:                 peerParams.DecayToZero,
+		RetainScore:                 peerParams.RetainScore,
+		SeenMsgTTL:                  peerParams.SeenMsgTTL,
+	})
+
+	// a peer getting maxBehaviourPenaltiesPerEpoch penalties every decay interval converges to the gossip threshold (P7)
+	require.EqualValues(t, test.OneEpochDuration, peerParams.DecayInterval)
+	penalties := 0.0
+	for i := 0; i < 1000; i++ {
+		penalties = penalties*peerParams.BehaviourPenaltyDecay + maxBehaviourPenaltiesPerEpoch
+	}
+	excess := penalties - peerParams.BehaviourPenaltyThreshold
+	require.Greater(t, excess, 0.0)
+	require.InDelta(t, float64(scoring.GossipThreshold), peerParams.BehaviourPenaltyWeight*excess*excess, 1e-6)
+
+	decidedParams, err := scoring.TopicScoreParams(network, scoring.DecidedTopicOpts(network))
+	require.NoError(t, err)
+	require.EqualValues(t, test.ExpectedDecidedParams, decidedParams)
+
+	subnetParams, err := scoring.TopicScoreParams(network, scoring.SubnetTopicOpts(network))
+	require.NoError(t, err)
+	require.EqualValues(t, test.ExpectedSubnetParams, subnetParams)
+}
@@ -0,0 +1,101 @@
+package topics
+
+import (
+	"encoding/hex"
+
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+func decodeHex(s string) []byte {
+	byts, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err.Error())
+	}
+	return byts
+}
+
+// genesisSubnetVectors are the subnet test vectors for the genesis fork
+var genesisSubnetVectors = []*SubnetVector{
+	{
+		ValidatorPK:    decodeHex("8e80066551a81b318258709edaf7dd1f63cd686a0e4db8b29bbb7acfe65608677af5a527d9448ee47835485e02b50bc0"),
+		ExpectedSubnet: 73,
+		ExpectedTopic:  "ssv.v2.73",
+	},
+	{
+		ValidatorPK:    decodeHex("948fb44582ce25336fdb17122eac64fe5a1afc39174ce92d6013becac116766dc5a778c880dd47de7dfff6a0f86ba42b"),
+		ExpectedSubnet: 99,
+		ExpectedTopic:  "ssv.v2.99",
+	},
+	{
+		Committee:      []types.OperatorID{1, 2, 3, 4},
+		ExpectedSubnet: 98,
+		ExpectedTopic:  "ssv.v2.98",
+	},
+	{
+		Committee:      []types.OperatorID{4, 3, 2, 1},
+		ExpectedSubnet: 98,
+		ExpectedTopic:  "ssv.v2.98",
+	},
+	{
+		Committee:      []types.OperatorID{1, 2, 3, 4, 5, 6, 7},
+		ExpectedSubnet: 117,
+		ExpectedTopic:  "ssv.v2.117",
+	},
+	{
+		Committee:      []types.OperatorID{5, 9, 101, 3000},
+		ExpectedSubnet: 80,
+		ExpectedTopic:  "ssv.v2.80",
+	},
+}
+
+// genesisMsgIDVectors are the message ID test vectors for the genesis fork
+var genesisMsgIDVectors = []*MsgIDVector{
+	{
+		Data:          []byte{},
+		ExpectedMsgID: decodeHex("e3b0c44298fc1c149afbf4c8996fb92427ae41e4"),
+	},
+	{
+		Data:          []byte{1, 2, 3, 4},
+		ExpectedMsgID: decodeHex("9f64a747e1b97f131fabb6b447296c9b6f0201e7"),
+	},
+	{
+		Data:          []byte("ssv"),
+		ExpectedMsgID: decodeHex("9cb5925c14a5f303ec7f32b9bf52234d84dbcc62"),
+	},
+}
+
+// GenesisMainnet tests the mainnet genesis fork vectors
+func GenesisMainnet() *TopicsSpecTest {
+	return &TopicsSpecTest{
+		Name:                 "genesis mainnet",
+		NetworkID:            types.MainnetNetworkID,
+		Epoch:                0,
+		SubnetVectors:        genesisSubnetVectors,
+		MsgIDVectors:         genesisMsgIDVectors,
+		ExpectedSubnetsCount: 128,
+		ExpectedDecidedTopic: "ssv.v2.decided",
+	}
+}
+
+// GenesisJatoV2 tests the jato v2 testnet genesis fork vectors at a later epoch
+func GenesisJatoV2() *TopicsSpecTest {
+	return &TopicsSpecTest{
+		Name:                 "genesis jato v2",
+		NetworkID:            types.JatoV2NetworkID,
+		Epoch:                100000,
+		SubnetVectors:        genesisSubnetVectors,
+		MsgIDVectors:         genesisMsgIDVectors,
+		ExpectedSubnetsCount: 128,
+		ExpectedDecidedTopic: "ssv.v2.decided",
+	}
+}
+
+// UnknownNetwork tests a network without forks
+func UnknownNetwork() *TopicsSpecTest {
+	return &TopicsSpecTest{
+		Name:          "unknown network",
+		NetworkID:     types.NetworkID{0xff},
+		Epoch:         0,
+		ExpectedError: "could not get fork: Fork list by GetForksData is empty. Unknown Network",
+	}
+}
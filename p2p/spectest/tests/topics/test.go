@@ -0,0 +1,67 @@
+package topics
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/ssv-spec/p2p/topics"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+// SubnetVector is a test vector for a validator or a committee, only one of ValidatorPK and Committee is set
+type SubnetVector struct {
+	ValidatorPK    []byte
+	Committee      []types.OperatorID
+	ExpectedSubnet uint64
+	ExpectedTopic  string
+}
+
+// MsgIDVector is a test vector for a pubsub message ID of encoded message data
+type MsgIDVector struct {
+	Data          []byte
+	ExpectedMsgID []byte
+}
+
+// TopicsSpecTest tests the topics config of the network's fork at the given epoch
+type TopicsSpecTest struct {
+	Name                 string
+	NetworkID            types.NetworkID
+	Epoch                phase0.Epoch
+	SubnetVectors        []*SubnetVector
+	MsgIDVectors         []*MsgIDVector
+	ExpectedSubnetsCount uint64
+	ExpectedDecidedTopic string
+	ExpectedError        string
+}
+
+func (test *TopicsSpecTest) TestName() string {
+	return "topics " + test.Name
+}
+
+func (test *TopicsSpecTest) Run(t *testing.T) {
+	config, err := topics.ConfigAtEpoch(test.NetworkID, test.Epoch)
+	if len(test.ExpectedError) > 0 {
+		require.EqualError(t, err, test.ExpectedError)
+		return
+	}
+	require.NoError(t, err)
+
+	require.EqualValues(t, test.ExpectedSubnetsCount, config.SubnetsCount)
+	require.EqualValues(t, test.ExpectedDecidedTopic, config.DecidedTopic())
+
+	for _, v := range test.SubnetVectors {
+		var subnet uint64
+		if len(v.ValidatorPK) > 0 {
+			subnet = config.ValidatorSubnet(v.ValidatorPK)
+		} else {
+			subnet = config.CommitteeSubnet(v.Committee)
+		}
+		require.EqualValues(t, v.ExpectedSubnet, subnet)
+		require.EqualValues(t, v.ExpectedTopic, config.SubnetTopic(subnet))
+	}
+
+	for _, v := range test.MsgIDVectors {
+		require.EqualValues(t, v.ExpectedMsgID, config.MsgID(v.Data))
+	}
+}
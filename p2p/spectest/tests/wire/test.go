@@ -0,0 +1,120 @@
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ssvlabs/ssv-spec/p2p/validation"
+	"github.com/ssvlabs/ssv-spec/p2p/wire"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// WireSpecTest tests the wire encoding of a SignedSSVMessage.
+// If Message is set it's encoded with Version and decoded back, if MaxSize is set a message of the max size is encoded with
+// every version and decoded back, otherwise Data is decoded and validated.
+type WireSpecTest struct {
+	Name    string
+	Message *types.SignedSSVMessage
+	Version wire.Version `json:",omitempty"`
+	// MaxSize tests a message of MaxEncodedMsgSize, too big to be included in the test
+	MaxSize bool `json:",omitempty"`
+	Data    []byte
+	// DataSize pads Data with zeros up to the given size, for inputs too big to be included in the test
+	DataSize int
+	// AcceptUncompressed validates Data accepting uncompressed messages (see validation.MessageValidator.AcceptUncompressed)
+	AcceptUncompressed      bool `json:",omitempty"`
+	ExpectedError           string
+	ExpectedValidationError string
+}
+
+func (test *WireSpecTest) TestName() string {
+	return "wire " + test.Name
+}
+
+func (test *WireSpecTest) Run(t *testing.T) {
+	if test.MaxSize {
+		test.runMaxSize(t)
+		return
+	}
+
+	if test.Message != nil {
+		encoded, err := wire.EncodeSignedSSVMessage(test.Message, test.Version)
+		if len(test.ExpectedError) != 0 {
+			require.EqualError(t, err, test.ExpectedError)
+			return
+		}
+		require.NoError(t, err)
+		require.EqualValues(t, test.Version, encoded[0])
+
+		decoded, err := wire.DecodeSignedSSVMessage(encoded)
+		require.NoError(t, err)
+		require.EqualValues(t, test.Message, decoded)
+
+		// the decompressed data is the message's encoding
+		expected, err := test.Message.Encode()
+		require.NoError(t, err)
+		decompressed, err := wire.Decode(encoded)
+		require.NoError(t, err)
+		require.EqualValues(t, expected, decompressed)
+		return
+	}
+
+	data := test.Data
+	if test.DataSize > len(data) {
+		data = append(data, make([]byte, test.DataSize-len(data))...)
+	}
+
+	_, err := wire.DecodeSignedSSVMessage(data)
+	require.EqualError(t, err, test.ExpectedError)
+
+	ks := testingutils.Testing4SharesSet()
+	mv := validation.NewMessageValidator(
+		testingutils.TestingSSVDomainType,
+		types.BeaconTestNetwork,
+		testingutils.NewTestingVerifier(),
+		func(validatorPK []byte) *types.Share {
+			return testingutils.TestingShare(ks)
+		},
+	)
+	mv.AcceptUncompressed = test.AcceptUncompressed
+	err = mv.ValidateWireMessage("", data, time.Unix(types.BeaconTestNetwork.EstimatedTimeAtSlot(testingutils.TestingDutySlot), 0))
+	if len(test.ExpectedValidationError) > 0 {
+		require.EqualError(t, err, test.ExpectedValidationError)
+	} else {
+		require.NoError(t, err)
+	}
+}
+
+// runMaxSize encodes a message of MaxEncodedMsgSize (an RSA signature and an SSVMessage of the max data size) with every version
+// and decodes it back, one more byte is too big
+func (test *WireSpecTest) runMaxSize(t *testing.T) {
+	ssvMsg := &types.SSVMessage{
+		MsgType: types.SSVConsensusMsgType,
+		MsgID:   types.MessageIDFromBytes(testingutils.AttesterMsgID),
+		Data:    make([]byte, 6291829),
+	}
+	data, err := ssvMsg.Encode()
+	require.NoError(t, err)
+	msg := &types.SignedSSVMessage{
+		Signature:  make([]byte, 256),
+		OperatorID: 1,
+		Data:       data,
+	}
+	encoded, err := msg.Encode()
+	require.NoError(t, err)
+	require.Len(t, encoded, wire.MaxEncodedMsgSize)
+
+	for _, version := range []wire.Version{wire.SnappyVersion, wire.VariableSignatureVersion} {
+		byts, err := wire.EncodeSignedSSVMessage(msg, version)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(byts), wire.MaxWireMsgSize)
+		decoded, err := wire.DecodeSignedSSVMessage(byts)
+		require.NoError(t, err)
+		require.EqualValues(t, msg, decoded)
+	}
+
+	_, err = wire.Encode(wire.VariableSignatureVersion, append(encoded, 0))
+	require.ErrorIs(t, err, wire.ErrTooBig)
+}
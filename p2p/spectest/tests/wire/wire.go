@@ -0,0 +1,172 @@
+package wire
+
+import (
+	"encoding/binary"
+
+	"github.com/golang/snappy"
+	"github.com/ssvlabs/ssv-spec/p2p/wire"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// RoundTripConsensus tests encoding and decoding a consensus message
+func RoundTripConsensus() *WireSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.TestingPrepareMessageWithParams(ks.Shares[1], 1, qbft.FirstRound, qbft.Height(testingutils.TestingDutySlot), testingutils.AttesterMsgID, testingutils.TestingQBFTRootData)
+	return &WireSpecTest{
+		Name:    "round trip consensus",
+		Message: testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(msg, nil)),
+		Version: wire.SnappyVersion,
+	}
+}
+
+// RoundTripPartialSig tests encoding and decoding a partial signature message
+func RoundTripPartialSig() *WireSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, qbft.Height(testingutils.TestingDutySlot))
+	return &WireSpecTest{
+		Name:    "round trip partial sig",
+		Message: testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(nil, msg)),
+		Version: wire.SnappyVersion,
+	}
+}
+
+// RoundTripVariableSignature tests encoding and decoding a message with a variable size signature version
+func RoundTripVariableSignature() *WireSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, qbft.Height(testingutils.TestingDutySlot))
+	return &WireSpecTest{
+		Name:    "round trip variable signature",
+		Message: testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(nil, msg)),
+		Version: wire.VariableSignatureVersion,
+	}
+}
+
+// LegacyVersionShortSignature tests a signature not of an RSA key doesn't fit the legacy encoding of SnappyVersion
+func LegacyVersionShortSignature() *WireSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, qbft.Height(testingutils.TestingDutySlot))))
+	msg.Signature = msg.Signature[:64]
+	return &WireSpecTest{
+		Name:          "legacy version short signature",
+		Message:       msg,
+		Version:       wire.SnappyVersion,
+		ExpectedError: "could not transcode to legacy encoding: unexpected legacy signature size of 64",
+	}
+}
+
+// MaxSize tests encoding and decoding a message of the max size with every version
+func MaxSize() *WireSpecTest {
+	return &WireSpecTest{
+		Name:    "max size",
+		MaxSize: true,
+	}
+}
+
+// EmptyData tests decoding empty data
+func EmptyData() *WireSpecTest {
+	return &WireSpecTest{
+		Name:                    "empty data",
+		Data:                    []byte{},
+		ExpectedError:           "empty data",
+		ExpectedValidationError: "empty message data",
+	}
+}
+
+// UnknownVersion tests decoding data with an unknown version byte
+func UnknownVersion() *WireSpecTest {
+	data, _ := wire.Encode(wire.VariableSignatureVersion, []byte{1, 2, 3, 4})
+	data[0] = 3
+	return &WireSpecTest{
+		Name:                    "unknown version",
+		Data:                    data,
+		ExpectedError:           "unknown wire version 3",
+		ExpectedValidationError: "unknown wire version 3: malformed message",
+	}
+}
+
+// CorruptData tests decoding data that isn't a valid snappy block
+func CorruptData() *WireSpecTest {
+	return &WireSpecTest{
+		Name:                    "corrupt data",
+		Data:                    []byte{byte(wire.SnappyVersion), 10, 0xff, 0xff, 0xff},
+		ExpectedError:           "could not decompress: snappy: corrupt input",
+		ExpectedValidationError: "could not decompress: snappy: corrupt input: malformed message",
+	}
+}
+
+// MalformedMessage tests decoding a valid snappy block that isn't a SignedSSVMessage
+func MalformedMessage() *WireSpecTest {
+	data, _ := wire.Encode(wire.VariableSignatureVersion, []byte{1, 2, 3, 4})
+	return &WireSpecTest{
+		Name:                    "malformed message",
+		Data:                    data,
+		ExpectedError:           "could not decode message: could not decode data into a SignedSSVMessage: unexpected encoded message size of 4",
+		ExpectedValidationError: "could not decode data into a SignedSSVMessage: unexpected encoded message size of 4: malformed message",
+	}
+}
+
+// MalformedLegacyMessage tests decoding a valid snappy block of SnappyVersion that isn't a SignedSSVMessage legacy encoding
+func MalformedLegacyMessage() *WireSpecTest {
+	data := append([]byte{byte(wire.SnappyVersion)}, snappy.Encode(nil, []byte{1, 2, 3, 4})...)
+	return &WireSpecTest{
+		Name:                    "malformed legacy message",
+		Data:                    data,
+		ExpectedError:           "could not decode legacy data into a SignedSSVMessage: unexpected encoded message size of 4",
+		ExpectedValidationError: "could not decode legacy data into a SignedSSVMessage: unexpected encoded message size of 4: malformed message",
+	}
+}
+
+// DecompressedTooBig tests a snappy block declaring a decompressed size above the limit is rejected before decompressing
+func DecompressedTooBig() *WireSpecTest {
+	data := []byte{byte(wire.SnappyVersion)}
+	data = binary.AppendUvarint(data, wire.MaxEncodedMsgSize+1)
+	return &WireSpecTest{
+		Name:                    "decompressed too big",
+		Data:                    data,
+		ExpectedError:           "decompressed size exceeds limit: message is too big",
+		ExpectedValidationError: "decompressed size exceeds limit: message is too big: message data is too big",
+	}
+}
+
+// CompressedTooBig tests data above the max wire size is rejected
+func CompressedTooBig() *WireSpecTest {
+	return &WireSpecTest{
+		Name:                    "compressed too big",
+		Data:                    []byte{byte(wire.SnappyVersion)},
+		DataSize:                wire.MaxWireMsgSize + 1,
+		ExpectedError:           "compressed size exceeds limit: message is too big",
+		ExpectedValidationError: "compressed size exceeds limit: message is too big: message data is too big",
+	}
+}
+
+// uncompressedMessage returns the uncompressed legacy encoding of a valid partial signature message, as sent before the wire encoding
+func uncompressedMessage() []byte {
+	ks := testingutils.Testing4SharesSet()
+	msg := testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, qbft.Height(testingutils.TestingDutySlot))
+	data, err := testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(nil, msg)).EncodeLegacy()
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// UncompressedAccepted tests an uncompressed message is accepted during the transition to the wire encoding
+func UncompressedAccepted() *WireSpecTest {
+	return &WireSpecTest{
+		Name:               "uncompressed accepted",
+		Data:               uncompressedMessage(),
+		AcceptUncompressed: true,
+		ExpectedError:      "unknown wire version 58",
+	}
+}
+
+// UncompressedRejected tests an uncompressed message is rejected after the transition to the wire encoding
+func UncompressedRejected() *WireSpecTest {
+	return &WireSpecTest{
+		Name:                    "uncompressed rejected",
+		Data:                    uncompressedMessage(),
+		ExpectedError:           "unknown wire version 58",
+		ExpectedValidationError: "unknown wire version 58: malformed message",
+	}
+}
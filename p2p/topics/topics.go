@@ -0,0 +1,113 @@
+package topics
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"math/big"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+const (
+	// MsgIDSize is the size of a pubsub message ID
+	MsgIDSize = 20
+	// DecidedTopicSuffix is the suffix of the topic decided messages are propagated on across the network
+	DecidedTopicSuffix = "decided"
+)
+
+// Config holds the topics related network configuration of a fork
+type Config struct {
+	// Domain of the fork
+	Domain types.DomainType
+	// SubnetsCount is the number of subnets
+	SubnetsCount uint64
+	// TopicPrefix is prepended to subnet topic names
+	TopicPrefix string
+}
+
+// genesisConfig returns the config of the genesis fork, see FORKS.md
+func genesisConfig(domain types.DomainType) *Config {
+	return &Config{
+		Domain:       domain,
+		SubnetsCount: 128,
+		TopicPrefix:  "ssv.v2",
+	}
+}
+
+// ConfigForFork returns the topics config for the fork
+func ConfigForFork(fork *types.ForkData) (*Config, error) {
+	switch fork.Domain {
+	case types.GenesisMainnet, types.PrimusTestnet, types.ShifuTestnet, types.ShifuV2Testnet, types.JatoTestnet, types.JatoV2Testnet:
+		return genesisConfig(fork.Domain), nil
+	default:
+		return nil, errors.Errorf("unknown fork domain %x", fork.Domain)
+	}
+}
+
+// ConfigAtEpoch returns the topics config for the network's fork at the given epoch
+func ConfigAtEpoch(networkID types.NetworkID, epoch phase0.Epoch) (*Config, error) {
+	fork, err := networkID.ForkAtEpoch(epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fork")
+	}
+	if fork == nil {
+		return nil, errors.Errorf("no fork at epoch %d", epoch)
+	}
+	return ConfigForFork(fork)
+}
+
+// ValidatorSubnet returns the subnet of the validator, computed as fnv64(hex(validatorPK)) % SubnetsCount
+func (c *Config) ValidatorSubnet(validatorPK []byte) uint64 {
+	h := fnv.New64()
+	_, _ = h.Write([]byte(hex.EncodeToString(validatorPK)))
+	return h.Sum64() % c.SubnetsCount
+}
+
+// CommitteeSubnet returns the subnet of the committee, computed as CommitteeID(committee) % SubnetsCount (CommitteeID as a big endian number)
+func (c *Config) CommitteeSubnet(committee []types.OperatorID) uint64 {
+	cid := CommitteeID(committee)
+	subnet := new(big.Int).Mod(new(big.Int).SetBytes(cid[:]), new(big.Int).SetUint64(c.SubnetsCount))
+	return subnet.Uint64()
+}
+
+// SubnetTopic returns the topic name of the subnet
+func (c *Config) SubnetTopic(subnet uint64) string {
+	return fmt.Sprintf("%s.%d", c.TopicPrefix, subnet)
+}
+
+// ValidatorTopic returns the topic name of the validator's subnet
+func (c *Config) ValidatorTopic(validatorPK []byte) string {
+	return c.SubnetTopic(c.ValidatorSubnet(validatorPK))
+}
+
+// DecidedTopic returns the topic name of the decided topic
+func (c *Config) DecidedTopic() string {
+	return fmt.Sprintf("%s.%s", c.TopicPrefix, DecidedTopicSuffix)
+}
+
+// MsgID returns the pubsub message ID of an encoded SignedSSVMessage, computed as sha256(data)[:MsgIDSize]
+func (c *Config) MsgID(data []byte) []byte {
+	h := sha256.Sum256(data)
+	return h[:MsgIDSize]
+}
+
+// CommitteeID returns the ID of a committee, computed as sha256 over the sorted operator IDs (each as a 4 bytes big endian number)
+func CommitteeID(committee []types.OperatorID) [32]byte {
+	ids := make([]types.OperatorID, len(committee))
+	copy(ids, committee)
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	byts := make([]byte, 0, 4*len(ids))
+	for _, id := range ids {
+		byts = binary.BigEndian.AppendUint32(byts, uint32(id))
+	}
+	return sha256.Sum256(byts)
+}
@@ -0,0 +1,122 @@
+package validation
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+func (mv *MessageValidator) validateConsensusMessage(
+	p peer.ID,
+	signedSSVMsg *types.SignedSSVMessage,
+	ssvMsg *types.SSVMessage,
+	share *types.Share,
+	receivedAt time.Time,
+) error {
+	msgID := ssvMsg.GetID()
+	role := msgID.GetRoleType()
+	if !consensusRoles[role] {
+		return ErrNoConsensusForRole
+	}
+
+	signedMsg := &qbft.SignedMessage{}
+	if err := signedMsg.Decode(ssvMsg.GetData()); err != nil {
+		return errors.Wrap(ErrMalformedMessage, err.Error())
+	}
+	if err := signedMsg.Validate(); err != nil {
+		return errors.Wrap(ErrInvalidMessage, err.Error())
+	}
+	if !bytes.Equal(signedMsg.Message.Identifier, msgID[:]) {
+		return ErrIdentifierMismatch
+	}
+
+	// Check signers
+	if !signedMsg.CommonSigners([]types.OperatorID{signedSSVMsg.OperatorID}) {
+		return ErrInconsistentSigner
+	}
+	for _, signer := range signedMsg.Signers {
+		if !signerInCommittee(signer, share.Committee) {
+			return ErrSignerNotInCommittee
+		}
+	}
+	decided := qbft.IsDecidedMsg(share, signedMsg)
+	if len(signedMsg.Signers) > 1 && !decided {
+		if signedMsg.Message.MsgType == qbft.CommitMsgType {
+			return ErrDecidedNotEnoughSigners
+		}
+		return ErrNonDecidedWithMultipleSigners
+	}
+
+	if len(signedMsg.FullData) > 0 {
+		root, err := qbft.HashDataRoot(signedMsg.FullData)
+		if err != nil {
+			return errors.Wrap(ErrInvalidFullData, err.Error())
+		}
+		if root != signedMsg.Message.Root {
+			return ErrInvalidFullData
+		}
+	}
+
+	// Check timing
+	slot := phase0.Slot(signedMsg.Message.Height)
+	if err := mv.validateSlotTime(slot, role, receivedAt); err != nil {
+		return err
+	}
+	if !decided {
+		if err := mv.validateRoundTime(slot, signedMsg.Message.Round, receivedAt); err != nil {
+			return err
+		}
+	}
+
+	// Check counters
+	key := signerStateKey{Peer: p, MsgID: msgID, Signer: signedSSVMsg.OperatorID}
+	state, err := mv.getSignerState(key, slot)
+	if err != nil {
+		return err
+	}
+	if decided {
+		if state.DecidedSigners[signersKey(signedMsg.Signers)] {
+			return ErrDecidedWithSameSigners
+		}
+		if uint64(len(state.DecidedSigners)) >= maxDecidedPerSlot(share) {
+			return ErrTooManyDecidedMessages
+		}
+	} else {
+		if signedMsg.Message.Round < state.Round {
+			return ErrRoundAlreadyAdvanced
+		}
+		if signedMsg.Message.Round == state.Round && state.MessageCounts[signedMsg.Message.MsgType] > 0 {
+			return ErrTooManyMessagesPerRound
+		}
+	}
+
+	// Verify signature
+	if err := signedMsg.Signature.VerifyByOperators(signedMsg, mv.DomainType, types.QBFTSignatureType, share.Committee); err != nil {
+		return ErrInvalidQBFTSignature
+	}
+
+	// Record message
+	if decided {
+		state.DecidedSigners[signersKey(signedMsg.Signers)] = true
+	} else {
+		if signedMsg.Message.Round > state.Round {
+			state.Round = signedMsg.Message.Round
+			state.MessageCounts = map[qbft.MessageType]int{}
+		}
+		state.MessageCounts[signedMsg.Message.MsgType]++
+	}
+	mv.storeSignerState(key, state)
+
+	return nil
+}
+
+// maxDecidedPerSlot returns the max number of decided messages a signer can send in a slot,
+// a decided message is sent for a quorum of commits (at least MinQuorumSize signers) and for every later commit adding a signer
+func maxDecidedPerSlot(share *types.Share) uint64 {
+	return uint64(len(share.Committee)) - share.MinQuorumSize() + 1
+}
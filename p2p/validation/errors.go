@@ -0,0 +1,64 @@
+package validation
+
+import (
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+)
+
+// Error is a message validation error.
+// Reject errors are caused by messages no honest peer would relay and penalize the sending peer,
+// all other errors are ignored as they could be caused by honest peers (e.g. late messages or an unknown validator)
+type Error struct {
+	Reason string
+	Reject bool
+}
+
+func (e *Error) Error() string {
+	return e.Reason
+}
+
+var (
+	// Ignore
+	ErrUnknownValidator       = &Error{Reason: "unknown validator"}
+	ErrEarlyMessage           = &Error{Reason: "message is too early"}
+	ErrLateMessage            = &Error{Reason: "message is too late"}
+	ErrSlotAlreadyAdvanced    = &Error{Reason: "signer already advanced to a later slot"}
+	ErrRoundAlreadyAdvanced   = &Error{Reason: "signer already advanced to a later round"}
+	ErrRoundTooHigh           = &Error{Reason: "round is too high for this slot"}
+	ErrDecidedWithSameSigners = &Error{Reason: "decided message with same signers already received"}
+
+	// Reject
+	ErrEmptyData                     = &Error{Reason: "empty message data", Reject: true}
+	ErrDataTooBig                    = &Error{Reason: "message data is too big", Reject: true}
+	ErrMalformedMessage              = &Error{Reason: "malformed message", Reject: true}
+	ErrWrongDomain                   = &Error{Reason: "wrong domain", Reject: true}
+	ErrUnknownRole                   = &Error{Reason: "unknown role", Reject: true}
+	ErrUnknownMessageType            = &Error{Reason: "unknown message type", Reject: true}
+	ErrSignerNotInCommittee          = &Error{Reason: "signer is not in committee", Reject: true}
+	ErrInvalidSignature              = &Error{Reason: "invalid operator signature", Reject: true}
+	ErrInconsistentSigner            = &Error{Reason: "inconsistent signer", Reject: true}
+	ErrInvalidMessage                = &Error{Reason: "invalid message", Reject: true}
+	ErrNoConsensusForRole            = &Error{Reason: "role has no consensus", Reject: true}
+	ErrIdentifierMismatch            = &Error{Reason: "identifier doesn't match message ID", Reject: true}
+	ErrInvalidFullData               = &Error{Reason: "full data hash doesn't match root", Reject: true}
+	ErrInvalidQBFTSignature          = &Error{Reason: "invalid qbft message signature", Reject: true}
+	ErrNonDecidedWithMultipleSigners = &Error{Reason: "non decided message with multiple signers", Reject: true}
+	ErrDecidedNotEnoughSigners       = &Error{Reason: "decided message without quorum of signers", Reject: true}
+	ErrTooManyMessagesPerRound       = &Error{Reason: "too many messages of the same type per round", Reject: true}
+	ErrTooManyDecidedMessages        = &Error{Reason: "too many decided messages per slot", Reject: true}
+	ErrPartialSigTypeMismatch        = &Error{Reason: "partial signature type doesn't match role", Reject: true}
+	ErrInvalidPartialSignature       = &Error{Reason: "invalid partial signature message signature", Reject: true}
+	ErrTooManyPartialSigs            = &Error{Reason: "too many partial signature messages per slot", Reject: true}
+)
+
+// ValidationResultForError returns the pubsub validation result for a validation error, nil error means accept
+func ValidationResultForError(err error) pubsub.ValidationResult {
+	if err == nil {
+		return pubsub.ValidationAccept
+	}
+	var valErr *Error
+	if errors.As(err, &valErr) && !valErr.Reject {
+		return pubsub.ValidationIgnore
+	}
+	return pubsub.ValidationReject
+}
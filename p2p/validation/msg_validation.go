@@ -1,118 +1,235 @@
 package validation
 
 import (
+	"bytes"
 	"context"
+	"sync"
+	"time"
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
-	"github.com/ssvlabs/ssv-spec/qbft"
-	"github.com/ssvlabs/ssv-spec/ssv"
+	"github.com/ssvlabs/ssv-spec/p2p/wire"
 	"github.com/ssvlabs/ssv-spec/types"
 )
 
+// MaxEncodedMsgSize is the max size of an encoded (decompressed) SignedSSVMessage, see wire.MaxEncodedMsgSize
+const MaxEncodedMsgSize = wire.MaxEncodedMsgSize
+
 // MsgValidatorFunc represents a message validator
 type MsgValidatorFunc = func(ctx context.Context, p peer.ID, msg *pubsub.Message) pubsub.ValidationResult
 
-func MsgValidation(runner ssv.Runner) MsgValidatorFunc {
-	return func(ctx context.Context, p peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
-		signedSSVMsg, err := DecodePubsubMsg(msg)
-		if err != nil {
-			return pubsub.ValidationReject
-		}
+// ShareGetterF returns the share of the validator with the given public key, nil if the validator is unknown
+type ShareGetterF func(validatorPK []byte) *types.Share
+
+// MessageValidator validates incoming pubsub messages before they are relayed.
+// It holds per peer, per validator and per signer counters to limit the number of messages a signer can send in a slot or round.
+type MessageValidator struct {
+	DomainType        types.DomainType
+	BeaconNetwork     types.BeaconNetwork
+	SignatureVerifier types.SignatureVerifier
+	GetShare          ShareGetterF
+	// AcceptUncompressed accepts uncompressed encoded messages (sent before the wire encoding) besides the wire encoding,
+	// set during the transition while peers may still send either
+	AcceptUncompressed bool
+
+	mtx   sync.Mutex
+	state map[signerStateKey]*signerState
+}
 
-		// Get SSVMessage
-		ssvMsg, err := signedSSVMsg.GetSSVMessageFromData()
-		if err != nil {
-			return pubsub.ValidationReject
-		}
+func NewMessageValidator(
+	domainType types.DomainType,
+	beaconNetwork types.BeaconNetwork,
+	signatureVerifier types.SignatureVerifier,
+	getShare ShareGetterF,
+) *MessageValidator {
+	return &MessageValidator{
+		DomainType:        domainType,
+		BeaconNetwork:     beaconNetwork,
+		SignatureVerifier: signatureVerifier,
+		GetShare:          getShare,
+		state:             map[signerStateKey]*signerState{},
+	}
+}
 
-		switch ssvMsg.GetType() {
-		case types.SSVConsensusMsgType:
-			if validateConsensusMsg(runner, ssvMsg.Data) != nil {
-				return pubsub.ValidationReject
-			}
-		case types.SSVPartialSignatureMsgType:
-			if validatePartialSigMsg(runner, ssvMsg.Data) != nil {
-				return pubsub.ValidationReject
-			}
-		default:
-			return pubsub.ValidationReject
-		}
+// MsgValidation returns a pubsub validator func for the message validator
+func MsgValidation(mv *MessageValidator) MsgValidatorFunc {
+	return MsgValidationAt(mv, time.Now)
+}
 
-		return pubsub.ValidationAccept
+// MsgValidationAt returns a pubsub validator func for the message validator, validating messages at the time returned by now
+// (e.g. the virtual time of a simulated network)
+func MsgValidationAt(mv *MessageValidator, now func() time.Time) MsgValidatorFunc {
+	return func(ctx context.Context, p peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		return ValidationResultForError(mv.ValidateWireMessage(p, msg.GetData(), now()))
 	}
 }
 
 func DecodePubsubMsg(msg *pubsub.Message) (*types.SignedSSVMessage, error) {
-	byts := msg.GetData()
-	ret := &types.SignedSSVMessage{}
-	if err := ret.Decode(byts); err != nil {
-		return nil, err
-	}
-	return ret, nil
+	return wire.DecodeSignedSSVMessage(msg.GetData())
 }
 
-func validateConsensusMsg(runner ssv.Runner, data []byte) error {
-	signedMsg := &qbft.SignedMessage{}
-	if err := signedMsg.Decode(data); err != nil {
-		return err
+// ValidateWireMessage decodes a message in its wire encoding and validates it, see ValidateMessage.
+// Size limits are checked before and after decompression, the decompressed size is checked before allocating.
+// With AcceptUncompressed, data that doesn't decode as the wire encoding is validated as an uncompressed legacy encoded message
+// (types.SignedSSVMessage.EncodeLegacy), as sent before the wire encoding.
+func (mv *MessageValidator) ValidateWireMessage(p peer.ID, data []byte, receivedAt time.Time) error {
+	if len(data) == 0 {
+		return ErrEmptyData
+	}
+	decoded, err := wire.Decode(data)
+	if err != nil && mv.AcceptUncompressed {
+		return mv.validateUncompressedMessage(p, data, receivedAt)
 	}
+	if err != nil {
+		if errors.Is(err, wire.ErrTooBig) {
+			return errors.Wrap(ErrDataTooBig, err.Error())
+		}
+		return errors.Wrap(ErrMalformedMessage, err.Error())
+	}
+	return mv.ValidateMessage(p, decoded, receivedAt)
+}
 
-	contr := runner.GetBaseRunner().QBFTController
+// validateUncompressedMessage validates an uncompressed legacy encoded message, see ValidateWireMessage
+func (mv *MessageValidator) validateUncompressedMessage(p peer.ID, data []byte, receivedAt time.Time) error {
+	if len(data) > wire.MaxLegacyEncodedMsgSize {
+		return ErrDataTooBig
+	}
+	encoded, err := wire.FromLegacy(data)
+	if err != nil {
+		return errors.Wrap(ErrMalformedMessage, err.Error())
+	}
+	return mv.ValidateMessage(p, encoded, receivedAt)
+}
 
-	if err := contr.BaseMsgValidation(signedMsg); err != nil {
+// ValidateMessage validates an encoded SignedSSVMessage received from a peer at the given time, returns nil if the message should be accepted.
+// Returned errors are of type *Error (possibly wrapped), use ValidationResultForError to map them to a pubsub validation result.
+func (mv *MessageValidator) ValidateMessage(p peer.ID, data []byte, receivedAt time.Time) error {
+	msg, err := mv.decodeMessage(data)
+	if err != nil {
 		return err
 	}
+	if err := mv.SignatureVerifier.Verify(msg.signed, msg.share.Committee); err != nil {
+		return ErrInvalidSignature
+	}
+	return mv.validateDecodedMessage(p, msg, receivedAt)
+}
+
+// ValidateMessages validates encoded SignedSSVMessages received from a peer at the given time, returns the error of each message
+// (see ValidateMessage). The operator signatures are verified at once if the SignatureVerifier is a types.BatchSignatureVerifier,
+// the messages are then validated in order as if received one after the other
+func (mv *MessageValidator) ValidateMessages(p peer.ID, data [][]byte, receivedAt time.Time) []error {
+	ret := make([]error, len(data))
+	decoded := make([]*decodedMessage, len(data))
+	toVerify := make([]int, 0, len(data))
+	for i := range data {
+		decoded[i], ret[i] = mv.decodeMessage(data[i])
+		if ret[i] == nil {
+			toVerify = append(toVerify, i)
+		}
+	}
 
-	/**
-	Main controller processing flow
-	_______________________________
-	All decided msgs are processed the same, out of instance
-	All valid future msgs are saved in a container and can trigger highest decided futuremsg
-	All other msgs (not future or decided) are processed normally by an existing instance (if found)
-	*/
-	if qbft.IsDecidedMsg(contr.Share, signedMsg) {
-		return qbft.ValidateDecided(contr.GetConfig(), signedMsg, contr.Share)
-	} else if signedMsg.Message.Height > contr.Height {
-		return validateFutureMsg(contr.GetConfig(), signedMsg, contr.Share.Committee)
+	if batchVerifier, ok := mv.SignatureVerifier.(types.BatchSignatureVerifier); ok {
+		msgs := make([]*types.SignedSSVMessage, 0, len(toVerify))
+		committees := make([][]*types.Operator, 0, len(toVerify))
+		for _, i := range toVerify {
+			msgs = append(msgs, decoded[i].signed)
+			committees = append(committees, decoded[i].share.Committee)
+		}
+		for j, err := range batchVerifier.VerifyMany(msgs, committees) {
+			if err != nil {
+				ret[toVerify[j]] = ErrInvalidSignature
+			}
+		}
 	} else {
-		if inst := contr.StoredInstances.FindInstance(signedMsg.Message.Height); inst != nil {
-			return inst.BaseMsgValidation(signedMsg)
+		for _, i := range toVerify {
+			if err := mv.SignatureVerifier.Verify(decoded[i].signed, decoded[i].share.Committee); err != nil {
+				ret[i] = ErrInvalidSignature
+			}
 		}
-		return errors.New("unknown instance")
 	}
+
+	for _, i := range toVerify {
+		if ret[i] == nil {
+			ret[i] = mv.validateDecodedMessage(p, decoded[i], receivedAt)
+		}
+	}
+	return ret
 }
 
-func validatePartialSigMsg(runner ssv.Runner, data []byte) error {
-	signedMsg := &types.SignedPartialSignatureMessage{}
-	if err := signedMsg.Decode(data); err != nil {
-		return err
+// decodedMessage is a decoded SignedSSVMessage passing the stateless checks, with its validator's share
+type decodedMessage struct {
+	signed *types.SignedSSVMessage
+	ssvMsg *types.SSVMessage
+	share  *types.Share
+}
+
+// decodeMessage decodes an encoded SignedSSVMessage and checks its structure, domain, role, validator and signer,
+// all but the operator signature and the signer's state
+func (mv *MessageValidator) decodeMessage(data []byte) (*decodedMessage, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyData
+	}
+	if len(data) > MaxEncodedMsgSize {
+		return nil, ErrDataTooBig
 	}
 
-	if signedMsg.Message.Type == types.PostConsensusPartialSig {
-		return runner.GetBaseRunner().ValidatePostConsensusMsg(runner, signedMsg)
+	signedSSVMsg := &types.SignedSSVMessage{}
+	if err := signedSSVMsg.Decode(data); err != nil {
+		return nil, errors.Wrap(ErrMalformedMessage, err.Error())
+	}
+	if err := signedSSVMsg.Validate(); err != nil {
+		return nil, errors.Wrap(ErrMalformedMessage, err.Error())
+	}
+
+	ssvMsg, err := signedSSVMsg.GetSSVMessageFromData()
+	if err != nil {
+		return nil, errors.Wrap(ErrMalformedMessage, err.Error())
+	}
+
+	msgID := ssvMsg.GetID()
+	if !bytes.Equal(msgID.GetDomain(), mv.DomainType[:]) {
+		return nil, ErrWrongDomain
+	}
+	if _, known := partialSigTypesForRole[msgID.GetRoleType()]; !known {
+		return nil, ErrUnknownRole
 	}
-	return runner.GetBaseRunner().ValidatePreConsensusMsg(runner, signedMsg)
-}
 
-func validateFutureMsg(
-	config qbft.IConfig,
-	msg *qbft.SignedMessage,
-	operators []*types.Operator,
-) error {
-	if err := msg.Validate(); err != nil {
-		return errors.Wrap(err, "invalid decided msg")
+	share := mv.GetShare(msgID.GetPubKey())
+	if share == nil {
+		return nil, ErrUnknownValidator
 	}
 
-	if len(msg.GetSigners()) != 1 {
-		return errors.New("allows 1 signer")
+	if !signerInCommittee(signedSSVMsg.OperatorID, share.Committee) {
+		return nil, ErrSignerNotInCommittee
 	}
+	return &decodedMessage{
+		signed: signedSSVMsg,
+		ssvMsg: ssvMsg,
+		share:  share,
+	}, nil
+}
 
-	// verify signature
-	if err := msg.Signature.VerifyByOperators(msg, config.GetSignatureDomainType(), types.QBFTSignatureType, operators); err != nil {
-		return errors.Wrap(err, "msg signature invalid")
+// validateDecodedMessage validates a decoded message, whose operator signature is valid, against the signer's state
+func (mv *MessageValidator) validateDecodedMessage(p peer.ID, msg *decodedMessage, receivedAt time.Time) error {
+	mv.mtx.Lock()
+	defer mv.mtx.Unlock()
+
+	switch msg.ssvMsg.GetType() {
+	case types.SSVConsensusMsgType:
+		return mv.validateConsensusMessage(p, msg.signed, msg.ssvMsg, msg.share, receivedAt)
+	case types.SSVPartialSignatureMsgType:
+		return mv.validatePartialSignatureMessage(p, msg.signed, msg.ssvMsg, msg.share, receivedAt)
+	default:
+		return ErrUnknownMessageType
 	}
+}
 
-	return nil
+func signerInCommittee(signer types.OperatorID, committee []*types.Operator) bool {
+	for _, operator := range committee {
+		if operator.OperatorID == signer {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,69 @@
+package validation
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+func (mv *MessageValidator) validatePartialSignatureMessage(
+	p peer.ID,
+	signedSSVMsg *types.SignedSSVMessage,
+	ssvMsg *types.SSVMessage,
+	share *types.Share,
+	receivedAt time.Time,
+) error {
+	msgID := ssvMsg.GetID()
+	role := msgID.GetRoleType()
+
+	signedMsg := &types.SignedPartialSignatureMessage{}
+	if err := signedMsg.Decode(ssvMsg.GetData()); err != nil {
+		return errors.Wrap(ErrMalformedMessage, err.Error())
+	}
+	if err := signedMsg.Validate(); err != nil {
+		return errors.Wrap(ErrInvalidMessage, err.Error())
+	}
+	if signedMsg.Signer != signedSSVMsg.OperatorID {
+		return ErrInconsistentSigner
+	}
+	if !partialSigTypeAllowed(role, signedMsg.Message.Type) {
+		return ErrPartialSigTypeMismatch
+	}
+
+	// Check timing
+	if err := mv.validateSlotTime(signedMsg.Message.Slot, role, receivedAt); err != nil {
+		return err
+	}
+
+	// Check counters
+	key := signerStateKey{Peer: p, MsgID: msgID, Signer: signedSSVMsg.OperatorID}
+	state, err := mv.getSignerState(key, signedMsg.Message.Slot)
+	if err != nil {
+		return err
+	}
+	if state.PartialSigCounts[signedMsg.Message.Type] > 0 {
+		return ErrTooManyPartialSigs
+	}
+
+	// Verify signature
+	if err := signedMsg.GetSignature().VerifyByOperators(signedMsg, mv.DomainType, types.PartialSignatureType, share.Committee); err != nil {
+		return ErrInvalidPartialSignature
+	}
+
+	// Record message
+	state.PartialSigCounts[signedMsg.Message.Type]++
+	mv.storeSignerState(key, state)
+
+	return nil
+}
+
+func partialSigTypeAllowed(role types.BeaconRole, msgType types.PartialSigMsgType) bool {
+	for _, allowed := range partialSigTypesForRole[role] {
+		if allowed == msgType {
+			return true
+		}
+	}
+	return false
+}
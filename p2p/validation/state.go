@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// signerStateKey identifies the messages a peer relayed for a validator's duty role on behalf of a signer
+type signerStateKey struct {
+	Peer   peer.ID
+	MsgID  types.MessageID
+	Signer types.OperatorID
+}
+
+// signerState counts the messages relayed by a peer for a signer in the signer's latest slot and round
+type signerState struct {
+	Slot  phase0.Slot
+	Round qbft.Round
+	// MessageCounts counts non decided qbft messages per type in Round
+	MessageCounts map[qbft.MessageType]int
+	// DecidedSigners holds the signers of the decided messages received in Slot
+	DecidedSigners map[string]bool
+	// PartialSigCounts counts partial signature messages per type in Slot
+	PartialSigCounts map[types.PartialSigMsgType]int
+}
+
+func newSignerState(slot phase0.Slot) *signerState {
+	return &signerState{
+		Slot:             slot,
+		MessageCounts:    map[qbft.MessageType]int{},
+		DecidedSigners:   map[string]bool{},
+		PartialSigCounts: map[types.PartialSigMsgType]int{},
+	}
+}
+
+// getSignerState returns the state for the key, a new state for the slot if none exists or it's of an older slot.
+// The returned state is stored only when a message is recorded with storeSignerState.
+func (mv *MessageValidator) getSignerState(key signerStateKey, slot phase0.Slot) (*signerState, error) {
+	s, found := mv.state[key]
+	if !found || s.Slot < slot {
+		return newSignerState(slot), nil
+	}
+	if s.Slot > slot {
+		return nil, ErrSlotAlreadyAdvanced
+	}
+	return s, nil
+}
+
+func (mv *MessageValidator) storeSignerState(key signerStateKey, s *signerState) {
+	mv.state[key] = s
+}
+
+// PruneState removes all counters for slots lower than the given slot, should be called periodically with the lowest slot still accepted
+func (mv *MessageValidator) PruneState(slot phase0.Slot) {
+	mv.mtx.Lock()
+	defer mv.mtx.Unlock()
+
+	for key, s := range mv.state {
+		if s.Slot < slot {
+			delete(mv.state, key)
+		}
+	}
+}
+
+// signersKey returns a unique key for a signers list
+func signersKey(signers []types.OperatorID) string {
+	return fmt.Sprint(signers)
+}
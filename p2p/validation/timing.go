@@ -0,0 +1,85 @@
+package validation
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+const (
+	// ClockErrorTolerance is the allowed clock difference between peers when checking if a message is early
+	ClockErrorTolerance = 50 * time.Millisecond
+	// LateSlotAllowance is the number of slots after the duty's slot in which its messages are still accepted
+	LateSlotAllowance = 2
+	// RoundAllowance is the number of rounds above the estimated current round that are still accepted (covers slow instance starts)
+	RoundAllowance = 1
+)
+
+// consensusRoles are the roles running a qbft instance
+var consensusRoles = map[types.BeaconRole]bool{
+	types.BNRoleAttester:                  true,
+	types.BNRoleAggregator:                true,
+	types.BNRoleProposer:                  true,
+	types.BNRoleSyncCommittee:             true,
+	types.BNRoleSyncCommitteeContribution: true,
+}
+
+// partialSigTypesForRole are the partial signature message types allowed for each role
+var partialSigTypesForRole = map[types.BeaconRole][]types.PartialSigMsgType{
+	types.BNRoleAttester:                  {types.PostConsensusPartialSig},
+	types.BNRoleAggregator:                {types.SelectionProofPartialSig, types.PostConsensusPartialSig},
+	types.BNRoleProposer:                  {types.RandaoPartialSig, types.PostConsensusPartialSig},
+	types.BNRoleSyncCommittee:             {types.PostConsensusPartialSig},
+	types.BNRoleSyncCommitteeContribution: {types.ContributionProofs, types.PostConsensusPartialSig},
+	types.BNRoleValidatorRegistration:     {types.ValidatorRegistrationPartialSig},
+	types.BNRoleVoluntaryExit:             {types.VoluntaryExitPartialSig},
+}
+
+// lateSlotAllowanceForRole returns the number of slots after the duty's slot in which messages of the role are accepted.
+// Attestations and aggregations can be included on chain up to an epoch after their slot, validator registrations and
+// voluntary exits are not time critical.
+func (mv *MessageValidator) lateSlotAllowanceForRole(role types.BeaconRole) phase0.Slot {
+	switch role {
+	case types.BNRoleAttester, types.BNRoleAggregator, types.BNRoleValidatorRegistration, types.BNRoleVoluntaryExit:
+		return phase0.Slot(mv.BeaconNetwork.SlotsPerEpoch()) + LateSlotAllowance
+	default:
+		return LateSlotAllowance
+	}
+}
+
+// validateSlotTime returns error if a message for the given slot is too early or too late to be received at the given time
+func (mv *MessageValidator) validateSlotTime(slot phase0.Slot, role types.BeaconRole, receivedAt time.Time) error {
+	slotStart := time.Unix(mv.BeaconNetwork.EstimatedTimeAtSlot(slot), 0)
+	if receivedAt.Add(ClockErrorTolerance).Before(slotStart) {
+		return ErrEarlyMessage
+	}
+
+	currentSlot := mv.BeaconNetwork.EstimatedSlotAtTime(receivedAt.Unix())
+	if currentSlot > slot+mv.lateSlotAllowanceForRole(role) {
+		return ErrLateMessage
+	}
+	return nil
+}
+
+// validateRoundTime returns error if the round is higher than the round an honest operator could reach at the given time
+func (mv *MessageValidator) validateRoundTime(slot phase0.Slot, round qbft.Round, receivedAt time.Time) error {
+	slotStart := time.Unix(mv.BeaconNetwork.EstimatedTimeAtSlot(slot), 0)
+	if round > estimatedRound(receivedAt.Sub(slotStart))+RoundAllowance {
+		return ErrRoundTooHigh
+	}
+	return nil
+}
+
+// estimatedRound returns the round an instance started at the beginning of the slot reaches after the given duration
+func estimatedRound(sinceSlotStart time.Duration) qbft.Round {
+	if sinceSlotStart < 0 {
+		return qbft.FirstRound
+	}
+	quickPhase := time.Duration(qbft.QuickTimeoutThreshold) * qbft.QuickTimeout
+	if sinceSlotStart < quickPhase {
+		return qbft.FirstRound + qbft.Round(sinceSlotStart/qbft.QuickTimeout)
+	}
+	return qbft.QuickTimeoutThreshold + qbft.FirstRound + qbft.Round((sinceSlotStart-quickPhase)/qbft.SlowTimeout)
+}
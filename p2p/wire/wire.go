@@ -0,0 +1,132 @@
+package wire
+
+import (
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// Version is the version of the wire encoding, prepended to every encoded message
+type Version byte
+
+const (
+	// SnappyVersion is a snappy (block format) compressed SignedSSVMessage legacy encoding (a fixed size RSA signature,
+	// see types.EncodeLegacySignedSSVMessage)
+	SnappyVersion Version = 1
+	// VariableSignatureVersion is a snappy (block format) compressed SignedSSVMessage encoding with a variable size signature
+	// (see types.EncodeSignedSSVMessage). Peers before it can't decode it, senders use SnappyVersion until the network upgraded
+	VariableSignatureVersion Version = 2
+)
+
+// MaxEncodedMsgSize is the max size of an encoded (decompressed) SignedSSVMessage:
+// 2 (signature size) + 256 (signature) + 8 (operator ID) + 8 (SSVMessage type) + 56 (message ID) + 4 (data offset) + 6291829 (max data)
+const MaxEncodedMsgSize = 2 + 256 + 8 + 8 + 56 + 4 + 6291829
+
+// MaxLegacyEncodedMsgSize is the max size of a SignedSSVMessage legacy encoding, without the signature size
+const MaxLegacyEncodedMsgSize = MaxEncodedMsgSize - 2
+
+// MaxWireMsgSize is the max size of a message on the wire, the version byte and the worst case snappy encoding of MaxEncodedMsgSize
+var MaxWireMsgSize = 1 + snappy.MaxEncodedLen(MaxEncodedMsgSize)
+
+// ErrTooBig is returned when the message, compressed or decompressed, exceeds its size limit
+var ErrTooBig = errors.New("message is too big")
+
+// Encode returns the version's wire encoding of the encoded SignedSSVMessage (types.SignedSSVMessage.Encode).
+// For SnappyVersion the message is transcoded to its legacy encoding, only RSA signatures fit it
+func Encode(version Version, data []byte) ([]byte, error) {
+	if len(data) > MaxEncodedMsgSize {
+		return nil, ErrTooBig
+	}
+	switch version {
+	case SnappyVersion:
+		legacy, err := toLegacy(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not transcode to legacy encoding")
+		}
+		data = legacy
+	case VariableSignatureVersion:
+	default:
+		return nil, errors.Errorf("unknown wire version %d", version)
+	}
+	return append([]byte{byte(version)}, snappy.Encode(nil, data)...), nil
+}
+
+// Decode returns the encoded SignedSSVMessage (types.SignedSSVMessage.Decode) from its wire encoding of any version.
+// The decompressed size is checked against the version's max size before decompressing, protecting against decompression bombs.
+func Decode(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty data")
+	}
+	if len(data) > MaxWireMsgSize {
+		return nil, errors.Wrap(ErrTooBig, "compressed size exceeds limit")
+	}
+
+	var maxSize int
+	switch Version(data[0]) {
+	case SnappyVersion:
+		maxSize = MaxLegacyEncodedMsgSize
+	case VariableSignatureVersion:
+		maxSize = MaxEncodedMsgSize
+	default:
+		return nil, errors.Errorf("unknown wire version %d", data[0])
+	}
+
+	compressed := data[1:]
+	size, err := snappy.DecodedLen(compressed)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read decompressed size")
+	}
+	if size > maxSize {
+		return nil, errors.Wrap(ErrTooBig, "decompressed size exceeds limit")
+	}
+	ret, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress")
+	}
+	if Version(data[0]) == SnappyVersion {
+		return FromLegacy(ret)
+	}
+	return ret, nil
+}
+
+// FromLegacy transcodes a SignedSSVMessage legacy encoding (types.SignedSSVMessage.EncodeLegacy) to its encoding
+func FromLegacy(data []byte) ([]byte, error) {
+	if len(data) > MaxLegacyEncodedMsgSize {
+		return nil, ErrTooBig
+	}
+	msg := &types.SignedSSVMessage{}
+	if err := msg.DecodeLegacy(data); err != nil {
+		return nil, err
+	}
+	return msg.Encode()
+}
+
+func toLegacy(data []byte) ([]byte, error) {
+	msg := &types.SignedSSVMessage{}
+	if err := msg.Decode(data); err != nil {
+		return nil, err
+	}
+	return msg.EncodeLegacy()
+}
+
+// EncodeSignedSSVMessage returns the version's wire encoding of the message
+func EncodeSignedSSVMessage(msg *types.SignedSSVMessage, version Version) ([]byte, error) {
+	data, err := msg.Encode()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode message")
+	}
+	return Encode(version, data)
+}
+
+// DecodeSignedSSVMessage returns the message from its wire encoding of any version
+func DecodeSignedSSVMessage(data []byte) (*types.SignedSSVMessage, error) {
+	decoded, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	ret := &types.SignedSSVMessage{}
+	if err := ret.Decode(decoded); err != nil {
+		return nil, errors.Wrap(err, "could not decode message")
+	}
+	return ret, nil
+}
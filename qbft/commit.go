@@ -37,8 +37,8 @@ func (i *Instance) UponCommit(signedCommit *SignedMessage, commitMsgContainer *M
 
 // returns true if there is a quorum for the current round for this provided value
 func commitQuorumForRoundRoot(state *State, commitMsgContainer *MsgContainer, root [32]byte, round Round) (bool, []*SignedMessage, error) {
-	signers, msgs := commitMsgContainer.LongestUniqueSignersForRoundAndRoot(round, root)
-	return state.Share.HasQuorum(len(signers)), msgs, nil
+	signers, msgs := commitMsgContainer.HeaviestUniqueSignersForRoundAndRoot(round, root, state.Share.OperatorWeight)
+	return state.Share.SignersHaveQuorum(signers), msgs, nil
 }
 
 func aggregateCommitMsgs(msgs []*SignedMessage, fullData []byte) (*SignedMessage, error) {
@@ -130,11 +130,7 @@ func baseCommitValidationVerifySignature(
 	}
 
 	// verify signature
-	if err := signedCommit.Signature.VerifyByOperators(signedCommit, config.GetSignatureDomainType(), types.QBFTSignatureType, operators); err != nil {
-		return errors.Wrap(err, "msg signature invalid")
-	}
-
-	return nil
+	return signatureError(VerifySignedMessages(config, []*SignedMessage{signedCommit}, operators)[0])
 }
 
 func validateCommit(
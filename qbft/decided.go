@@ -34,9 +34,9 @@ func (c *Controller) UponDecided(msg *SignedMessage) (*SignedMessage, error) {
 		inst.State.Round = msg.Message.Round
 		inst.State.DecidedValue = msg.FullData
 		inst.State.CommitContainer.AddMsg(msg)
-	} else { // decide previously, add if has more signers
-		signers, _ := inst.State.CommitContainer.LongestUniqueSignersForRoundAndRoot(msg.Message.Round, msg.Message.Root)
-		if len(msg.Signers) > len(signers) {
+	} else { // decide previously, add if has more voting power
+		signers, _ := inst.State.CommitContainer.HeaviestUniqueSignersForRoundAndRoot(msg.Message.Round, msg.Message.Root, c.Share.OperatorWeight)
+		if c.Share.SignersWeight(msg.Signers) > c.Share.SignersWeight(signers) {
 			inst.State.CommitContainer.AddMsg(msg)
 		}
 	}
@@ -69,6 +69,11 @@ func ValidateDecided(
 		return errors.Wrap(err, "invalid decided msg")
 	}
 
+	// signers are unique committee members, their voting power is counted as the msg containers do
+	if !share.SignersHaveQuorum(signedDecided.Signers) {
+		return errors.New("decided msg signers don't have quorum")
+	}
+
 	if err := signedDecided.Validate(); err != nil {
 		return errors.Wrap(err, "invalid decided")
 	}
@@ -84,7 +89,17 @@ func ValidateDecided(
 	return nil
 }
 
-// IsDecidedMsg returns true if signed commit has all quorum sigs
+// IsDecidedMsg returns true if signed commit claims all quorum sigs (by voting power).
+// Signers aren't validated here: each listed signer counts its voting power, an unknown signer 1, so a commit with duplicate or unknown
+// signers claiming quorum is identified as decided and rejected by ValidateDecided before its signers' voting power is counted
 func IsDecidedMsg(share *types.Share, signedDecided *SignedMessage) bool {
-	return share.HasQuorum(len(signedDecided.Signers)) && signedDecided.Message.MsgType == CommitMsgType
+	weight := uint64(0)
+	for _, signer := range signedDecided.Signers {
+		if signerWeight := share.OperatorWeight(signer); signerWeight > 0 {
+			weight += signerWeight
+		} else {
+			weight++
+		}
+	}
+	return weight >= share.Quorum && signedDecided.Message.MsgType == CommitMsgType
 }
@@ -0,0 +1,50 @@
+package qbft_test
+
+import (
+	"testing"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestController_UponDecidedMoreVotingPower(t *testing.T) {
+	// weights [2,1,...,1], W = 14, f = 4, q = 10
+	ks := testingutils.Testing13SharesSet()
+	share := testingutils.TestingWeightedShare(ks, map[types.OperatorID]uint64{1: 2})
+	require.NoError(t, share.Validate())
+	contr := testingutils.NewTestingQBFTController(testingutils.TestingIdentifier, share, testingutils.TestingConfig(ks))
+
+	decidedMsg := func(signers []types.OperatorID) *qbft.SignedMessage {
+		sks := make([]*bls.SecretKey, 0, len(signers))
+		for _, signer := range signers {
+			sks = append(sks, ks.Shares[signer])
+		}
+		return testingutils.TestingCommitMultiSignerMessage(sks, signers)
+	}
+
+	// 10 light signers, voting power 10
+	light := decidedMsg([]types.OperatorID{2, 3, 4, 5, 6, 7, 8, 9, 10, 11})
+	decided, err := contr.ProcessMsg(light)
+	require.NoError(t, err)
+	require.NotNil(t, decided)
+
+	// as many signers including the heavy one, voting power 11
+	heavy := decidedMsg([]types.OperatorID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	decided, err = contr.ProcessMsg(heavy)
+	require.NoError(t, err)
+	require.Nil(t, decided)
+
+	inst := contr.InstanceForHeight(qbft.FirstHeight)
+	require.NotNil(t, inst)
+	signers, _ := inst.State.CommitContainer.HeaviestUniqueSignersForRoundAndRoot(qbft.FirstRound, testingutils.TestingQBFTRootData, share.OperatorWeight)
+	require.EqualValues(t, 11, share.SignersWeight(signers))
+
+	// fewer voting power isn't added
+	lighter := decidedMsg([]types.OperatorID{2, 3, 4, 5, 6, 7, 8, 9, 10, 12})
+	_, err = contr.ProcessMsg(lighter)
+	require.NoError(t, err)
+	require.Len(t, inst.State.CommitContainer.Msgs[qbft.FirstRound], 2)
+}
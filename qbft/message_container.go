@@ -53,8 +53,20 @@ func (c *MsgContainer) MessagesForRoundAndRoot(round Round, root [32]byte) []*Si
 
 // LongestUniqueSignersForRoundAndRoot returns the longest set of unique signers and msgs for a specific round and value
 func (c *MsgContainer) LongestUniqueSignersForRoundAndRoot(round Round, root [32]byte) ([]types.OperatorID, []*SignedMessage) {
+	return c.HeaviestUniqueSignersForRoundAndRoot(round, root, func(types.OperatorID) uint64 {
+		return 1
+	})
+}
+
+// HeaviestUniqueSignersForRoundAndRoot returns the set of unique signers, and their msgs, with the highest total weight for a specific round and value
+func (c *MsgContainer) HeaviestUniqueSignersForRoundAndRoot(
+	round Round,
+	root [32]byte,
+	weight func(signer types.OperatorID) uint64,
+) ([]types.OperatorID, []*SignedMessage) {
 	signersRet := make([]types.OperatorID, 0)
 	msgsRet := make([]*SignedMessage, 0)
+	weightRet := uint64(0)
 	if c.Msgs[round] == nil {
 		return signersRet, msgsRet
 	}
@@ -83,9 +95,14 @@ func (c *MsgContainer) LongestUniqueSignersForRoundAndRoot(round Round, root [32
 			}
 		}
 
-		if len(signersRet) < len(currentSigners) {
+		currentWeight := uint64(0)
+		for _, signer := range currentSigners {
+			currentWeight += weight(signer)
+		}
+		if weightRet < currentWeight {
 			signersRet = currentSigners
 			msgsRet = currentMsgs
+			weightRet = currentWeight
 		}
 	}
 
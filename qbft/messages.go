@@ -14,26 +14,22 @@ func HashDataRoot(data []byte) ([32]byte, error) {
 	return ret, nil
 }
 
-// HasQuorum returns true if a unique set of signers has quorum
+// HasQuorum returns true if a unique set of signers has quorum (by voting power)
 func HasQuorum(share *types.Share, msgs []*SignedMessage) bool {
-	uniqueSigners := make(map[types.OperatorID]bool)
-	for _, msg := range msgs {
-		for _, signer := range msg.GetSigners() {
-			uniqueSigners[signer] = true
-		}
-	}
-	return share.HasQuorum(len(uniqueSigners))
+	return share.SignersHaveQuorum(msgsSigners(msgs))
 }
 
-// HasPartialQuorum returns true if a unique set of signers has partial quorum
+// HasPartialQuorum returns true if a unique set of signers has partial quorum (by voting power)
 func HasPartialQuorum(share *types.Share, msgs []*SignedMessage) bool {
-	uniqueSigners := make(map[types.OperatorID]bool)
+	return share.SignersHavePartialQuorum(msgsSigners(msgs))
+}
+
+func msgsSigners(msgs []*SignedMessage) []types.OperatorID {
+	ret := make([]types.OperatorID, 0)
 	for _, msg := range msgs {
-		for _, signer := range msg.GetSigners() {
-			uniqueSigners[signer] = true
-		}
+		ret = append(ret, msg.GetSigners()...)
 	}
-	return share.HasPartialQuorum(len(uniqueSigners))
+	return ret
 }
 
 type MessageType uint64
@@ -114,26 +114,6 @@ func validSignedPrepareForHeightRoundAndRootIgnoreSignature(
 	return nil
 }
 
-func validSignedPrepareForHeightRoundAndRootVerifySignature(
-	config IConfig,
-	signedPrepare *SignedMessage,
-	height Height,
-	round Round,
-	root [32]byte,
-	operators []*types.Operator) error {
-
-	if err := validSignedPrepareForHeightRoundAndRootIgnoreSignature(signedPrepare, height, round, root, operators); err != nil {
-		return err
-	}
-
-	// Verify signature
-	if err := signedPrepare.Signature.VerifyByOperators(signedPrepare, config.GetSignatureDomainType(), types.QBFTSignatureType, operators); err != nil {
-		return errors.Wrap(err, "msg signature invalid")
-	}
-
-	return nil
-}
-
 // CreatePrepare
 /**
 Prepare(
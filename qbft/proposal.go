@@ -125,11 +125,18 @@ func isProposalJustification(
 	if round == FirstRound {
 		return nil
 	} else {
+		// verify all justifications' signatures at once
+		signatureErrs := VerifySignedMessages(config, append(append([]*SignedMessage{}, roundChangeMsgs...), prepareMsgs...), state.Share.Committee)
+
 		// check all round changes are valid for height and round
 		// no quorum, duplicate signers,  invalid still has quorum, invalid no quorum
 		// prepared
-		for _, rc := range roundChangeMsgs {
-			if err := validRoundChangeForDataVerifySignature(state, config, rc, height, round, fullData); err != nil {
+		for i, rc := range roundChangeMsgs {
+			err := validRoundChangeForDataIgnoreSignature(state, config, rc, height, round)
+			if err == nil {
+				err = signatureError(signatureErrs[i])
+			}
+			if err != nil {
 				return errors.Wrap(err, "change round msg not valid")
 			}
 		}
@@ -180,15 +187,18 @@ func isProposalJustification(
 			}
 
 			// validate each prepare message against the highest previously prepared fullData and round
-			for _, pm := range prepareMsgs {
-				if err := validSignedPrepareForHeightRoundAndRootVerifySignature(
-					config,
+			for i, pm := range prepareMsgs {
+				err := validSignedPrepareForHeightRoundAndRootIgnoreSignature(
 					pm,
 					height,
 					rcm.Message.DataRound,
 					rcm.Message.Root,
 					state.Share.Committee,
-				); err != nil {
+				)
+				if err == nil {
+					err = signatureError(signatureErrs[len(roundChangeMsgs)+i])
+				}
+				if err != nil {
 					return errors.New("signed prepare not valid")
 				}
 			}
@@ -0,0 +1,83 @@
+package qbft
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// SeededRoundRobinProposer returns the proposer for the round, like RoundRobinProposer but the first round proposer of
+// each height is offset by a seed derived from the instance identifier (validator public key and role).
+// Different validators (and roles) of the same committee start their rounds with different proposers, spreading the proposer load.
+func SeededRoundRobinProposer(state *State, round Round) types.OperatorID {
+	h := sha256.Sum256(state.ID)
+	seed := binary.BigEndian.Uint64(h[:8])
+	committeeSize := uint64(len(state.Share.Committee))
+
+	index := (seed%committeeSize + uint64(state.Height)%committeeSize + uint64(round-FirstRound)%committeeSize) % committeeSize
+	return state.Share.Committee[index].OperatorID
+}
+
+// WeightedProposerF returns a ProposerF selecting the proposer with probability proportional to its weight (e.g. stake or reputation).
+// The selection is deterministic per identifier, height and round. Operators without a weight are never selected,
+// if no committee operator has weight RoundRobinProposer is used.
+func WeightedProposerF(weights map[types.OperatorID]uint64) ProposerF {
+	return func(state *State, round Round) types.OperatorID {
+		total := uint64(0)
+		for _, operator := range state.Share.Committee {
+			total += weights[operator.OperatorID]
+		}
+		if total == 0 {
+			return RoundRobinProposer(state, round)
+		}
+
+		target := selectionSeed(state.ID, state.Height, round) % total
+		for _, operator := range state.Share.Committee {
+			weight := weights[operator.OperatorID]
+			if target < weight {
+				return operator.OperatorID
+			}
+			target -= weight
+		}
+		return RoundRobinProposer(state, round) // unreachable, target < total
+	}
+}
+
+// SkipOfflineProposerF returns a ProposerF that selects the base proposer, if it's known to be offline the next online
+// operator in the committee is selected instead. If all the committee is offline the base proposer is returned.
+// Liveness data must be agreed on by the committee (e.g. derived from decided messages) for all operators to select the same proposer.
+func SkipOfflineProposerF(base ProposerF, isOffline func(operatorID types.OperatorID) bool) ProposerF {
+	return func(state *State, round Round) types.OperatorID {
+		proposer := base(state, round)
+		if !isOffline(proposer) {
+			return proposer
+		}
+
+		committee := state.Share.Committee
+		start := 0
+		for i, operator := range committee {
+			if operator.OperatorID == proposer {
+				start = i
+				break
+			}
+		}
+		for i := 1; i < len(committee); i++ {
+			candidate := committee[(start+i)%len(committee)].OperatorID
+			if !isOffline(candidate) {
+				return candidate
+			}
+		}
+		return proposer
+	}
+}
+
+// selectionSeed returns a deterministic pseudo random number for the identifier, height and round
+func selectionSeed(identifier []byte, height Height, round Round) uint64 {
+	data := make([]byte, 0, len(identifier)+16)
+	data = append(data, identifier...)
+	data = binary.LittleEndian.AppendUint64(data, uint64(height))
+	data = binary.LittleEndian.AppendUint64(data, uint64(round))
+	h := sha256.Sum256(data)
+	return binary.BigEndian.Uint64(h[:8])
+}
@@ -47,8 +47,8 @@ func (i *Instance) uponRoundChange(
 			i.State,
 			i.config,
 			valueToPropose,
-			roundChangeMsgContainer.MessagesForRound(i.State.Round), // TODO - might be optimized to include only necessary quorum
-			roundChangeJustification,
+			minimalRoundChangeJustification(i.State.Share, roundChangeMsgContainer.MessagesForRound(i.State.Round)),
+			minimalQuorum(i.State.Share, roundChangeJustification, nil),
 		)
 		if err != nil {
 			return errors.Wrap(err, "failed to create proposal")
@@ -126,7 +126,10 @@ func hasReceivedProposalJustificationForLeadingRound(
 		// If justifiedRoundChangeMsg has prepare justification chose prepared value
 		valueToPropose := instanceStartValue
 		if msg.Message.RoundChangePrepared() {
-			valueToPropose = signedRoundChange.FullData
+			valueToPropose = preparedValue(state, roundChanges)
+			if valueToPropose == nil {
+				continue // prepared value is unknown locally, can't propose it
+			}
 		}
 
 		roundChangeJustification, _ := msg.Message.GetRoundChangeJustifications() // no need to check error, checked on isValidRoundChange
@@ -207,13 +210,14 @@ func isReceivedProposalJustification(
 	return nil
 }
 
+// validRoundChangeForDataIgnoreSignature returns nil if the round change is valid for the height and round.
+// Round changes carry only the prepared root, full data is optional and if attached it must match the root.
 func validRoundChangeForDataIgnoreSignature(
 	state *State,
 	config IConfig,
 	signedMsg *SignedMessage,
 	height Height,
 	round Round,
-	fullData []byte,
 ) error {
 	if signedMsg.Message.MsgType != RoundChangeMsgType {
 		return errors.New("round change msg type is wrong")
@@ -239,27 +243,33 @@ func validRoundChangeForDataIgnoreSignature(
 	// Addition to formal spec
 	// We add this extra tests on the msg itself to filter round change msgs with invalid justifications, before they are inserted into msg containers
 	if signedMsg.Message.RoundChangePrepared() {
-		r, err := HashDataRoot(fullData)
-		if err != nil {
-			return errors.Wrap(err, "could not hash input data")
-		}
-
 		// validate prepare message justifications
 		prepareMsgs, _ := signedMsg.Message.GetRoundChangeJustifications() // no need to check error, checked on signedMsg.Message.Validate()
-		for _, pm := range prepareMsgs {
-			if err := validSignedPrepareForHeightRoundAndRootVerifySignature(
-				config,
+		// verify all justifications' signatures at once
+		signatureErrs := VerifySignedMessages(config, prepareMsgs, state.Share.Committee)
+		for i, pm := range prepareMsgs {
+			err := validSignedPrepareForHeightRoundAndRootIgnoreSignature(
 				pm,
 				state.Height,
 				signedMsg.Message.DataRound,
 				signedMsg.Message.Root,
-				state.Share.Committee); err != nil {
+				state.Share.Committee)
+			if err == nil {
+				err = signatureError(signatureErrs[i])
+			}
+			if err != nil {
 				return errors.Wrap(err, "round change justification invalid")
 			}
 		}
 
-		if !bytes.Equal(r[:], signedMsg.Message.Root[:]) {
-			return errors.New("H(data) != root")
+		if len(signedMsg.FullData) > 0 {
+			r, err := HashDataRoot(signedMsg.FullData)
+			if err != nil {
+				return errors.Wrap(err, "could not hash input data")
+			}
+			if !bytes.Equal(r[:], signedMsg.Message.Root[:]) {
+				return errors.New("H(data) != root")
+			}
 		}
 
 		if !HasQuorum(state.Share, prepareMsgs) {
@@ -276,27 +286,6 @@ func validRoundChangeForDataIgnoreSignature(
 	return nil
 }
 
-func validRoundChangeForDataVerifySignature(
-	state *State,
-	config IConfig,
-	signedMsg *SignedMessage,
-	height Height,
-	round Round,
-	fullData []byte,
-) error {
-
-	if err := validRoundChangeForDataIgnoreSignature(state, config, signedMsg, height, round, fullData); err != nil {
-		return err
-	}
-
-	// Verify signature
-	if err := signedMsg.Signature.VerifyByOperators(signedMsg, config.GetSignatureDomainType(), types.QBFTSignatureType, state.Share.Committee); err != nil {
-		return errors.Wrap(err, "msg signature invalid")
-	}
-
-	return nil
-}
-
 // highestPrepared returns a round change message with the highest prepared round, returns nil if none found
 func highestPrepared(roundChanges []*SignedMessage) (*SignedMessage, error) {
 	var ret *SignedMessage
@@ -316,6 +305,82 @@ func highestPrepared(roundChanges []*SignedMessage) (*SignedMessage, error) {
 	return ret, nil
 }
 
+// preparedValue returns the full data of the highest prepared round change, nil if none is prepared or the full data is unknown.
+// Round changes carry only the prepared root, the full data is taken from the local state (last prepared value or a received proposal).
+func preparedValue(state *State, roundChanges []*SignedMessage) []byte {
+	rc, _ := highestPrepared(roundChanges)
+	if rc == nil {
+		return nil
+	}
+
+	candidates := [][]byte{state.LastPreparedValue}
+	if state.ProposeContainer != nil {
+		for _, proposal := range state.ProposeContainer.AllMessaged() {
+			candidates = append(candidates, proposal.FullData)
+		}
+	}
+	for _, fullData := range candidates {
+		if len(fullData) == 0 {
+			continue
+		}
+		r, err := HashDataRoot(fullData)
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(r[:], rc.Message.Root[:]) {
+			return fullData
+		}
+	}
+	return nil
+}
+
+// minimalRoundChangeJustification returns the smallest quorum of round changes that includes the highest prepared round change
+func minimalRoundChangeJustification(share *types.Share, roundChanges []*SignedMessage) []*SignedMessage {
+	rc, _ := highestPrepared(roundChanges)
+	if rc == nil {
+		return minimalQuorum(share, roundChanges, nil)
+	}
+	return minimalQuorum(share, roundChanges, []*SignedMessage{rc})
+}
+
+// minimalQuorum returns the smallest subset of msgs, including the required msgs, whose unique signers have quorum.
+// Messages keep their order, if msgs have no quorum all of them are returned
+func minimalQuorum(share *types.Share, msgs []*SignedMessage, required []*SignedMessage) []*SignedMessage {
+	selected := make(map[*SignedMessage]bool)
+	signers := make([]types.OperatorID, 0)
+	add := func(msg *SignedMessage) {
+		selected[msg] = true
+		signers = append(signers, msg.GetSigners()...)
+	}
+	addsSigner := func(msg *SignedMessage) bool {
+		return share.SignersWeight(append(signers, msg.GetSigners()...)) > share.SignersWeight(signers)
+	}
+
+	for _, msg := range required {
+		add(msg)
+	}
+	for _, msg := range msgs {
+		if share.SignersHaveQuorum(signers) {
+			break
+		}
+		if selected[msg] || !addsSigner(msg) {
+			continue
+		}
+		add(msg)
+	}
+	if !share.SignersHaveQuorum(signers) {
+		return msgs
+	}
+
+	ret := make([]*SignedMessage, 0, len(selected))
+	for _, msg := range msgs {
+		if selected[msg] {
+			ret = append(ret, msg)
+		}
+	}
+	return ret
+}
+
 // returns the min round number out of the signed round change messages and the current round
 func minRound(roundChangeMsgs []*SignedMessage) Round {
 	ret := NoRound
@@ -327,21 +392,21 @@ func minRound(roundChangeMsgs []*SignedMessage) Round {
 	return ret
 }
 
-func getRoundChangeData(state *State, config IConfig, instanceStartValue []byte) (Round, [32]byte, []byte, []*SignedMessage, error) {
+func getRoundChangeData(state *State, config IConfig, instanceStartValue []byte) (Round, [32]byte, []*SignedMessage, error) {
 	if state.LastPreparedRound != NoRound && state.LastPreparedValue != nil {
 		justifications, err := getRoundChangeJustification(state, config, state.PrepareContainer)
 		if err != nil {
-			return NoRound, [32]byte{}, nil, nil, errors.Wrap(err, "could not get round change justification")
+			return NoRound, [32]byte{}, nil, errors.Wrap(err, "could not get round change justification")
 		}
 
 		r, err := HashDataRoot(state.LastPreparedValue)
 		if err != nil {
-			return NoRound, [32]byte{}, nil, nil, errors.Wrap(err, "could not hash input data")
+			return NoRound, [32]byte{}, nil, errors.Wrap(err, "could not hash input data")
 		}
 
-		return state.LastPreparedRound, r, state.LastPreparedValue, justifications, nil
+		return state.LastPreparedRound, r, justifications, nil
 	}
-	return NoRound, [32]byte{}, nil, nil, nil
+	return NoRound, [32]byte{}, nil, nil
 }
 
 // CreateRoundChange
@@ -357,9 +422,11 @@ RoundChange(
            current.lastPreparedBlock,
            getRoundChangeJustification(current)
        )
+
+Round changes carry only the prepared root, not the prepared block. A leader proposes the prepared block from its local state (see preparedValue).
 */
 func CreateRoundChange(state *State, config IConfig, newRound Round, instanceStartValue []byte) (*SignedMessage, error) {
-	round, root, fullData, justifications, err := getRoundChangeData(state, config, instanceStartValue)
+	round, root, justifications, err := getRoundChangeData(state, config, instanceStartValue)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not generate round change data")
 	}
@@ -387,8 +454,6 @@ func CreateRoundChange(state *State, config IConfig, newRound Round, instanceSta
 		Signature: sig,
 		Signers:   []types.OperatorID{state.Share.OperatorID},
 		Message:   *msg,
-
-		FullData: fullData,
 	}
 	return signedMsg, nil
 }
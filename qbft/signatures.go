@@ -0,0 +1,57 @@
+package qbft
+
+import (
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// VerifySignedMessages verifies the messages' signatures by the operators at once (see types.SignatureBatch) with the config's
+// signature cache, e.g. a proposal's justifications. Returns the error of each message, nil if its signature is valid
+func VerifySignedMessages(config IConfig, msgs []*SignedMessage, operators []*types.Operator) []error {
+	data := make([]types.MessageSignature, 0, len(msgs))
+	for _, msg := range msgs {
+		data = append(data, msg)
+	}
+	return types.VerifyByOperatorsBatch(data, config.GetSignatureDomainType(), types.QBFTSignatureType, operators, config.GetSignatureCache())
+}
+
+// signatureError returns a message's signature error (see VerifySignedMessages) as a validation error, nil if the signature
+// is valid
+func signatureError(err error) error {
+	if err != nil {
+		return errors.Wrap(err, "msg signature invalid")
+	}
+	return nil
+}
+
+// SignaturesVerifiedByProcessing returns the signed messages whose signatures may be verified processing the message: a decided
+// message, a proposal's justifications and a prepared round change's justifications (the signature of any other message isn't
+// verified). Justifications that can't be decoded are skipped
+func SignaturesVerifiedByProcessing(share *types.Share, msg *SignedMessage) []*SignedMessage {
+	switch msg.Message.MsgType {
+	case CommitMsgType:
+		if IsDecidedMsg(share, msg) {
+			return []*SignedMessage{msg}
+		}
+	case ProposalMsgType:
+		ret, _ := msg.Message.GetPrepareJustifications()
+		roundChanges, _ := msg.Message.GetRoundChangeJustifications()
+		for _, rc := range roundChanges {
+			ret = append(ret, rc)
+			ret = append(ret, preparedJustifications(rc)...)
+		}
+		return ret
+	case RoundChangeMsgType:
+		return preparedJustifications(msg)
+	}
+	return nil
+}
+
+// preparedJustifications returns a prepared round change's prepare justifications
+func preparedJustifications(rc *SignedMessage) []*SignedMessage {
+	if !rc.Message.RoundChangePrepared() {
+		return nil
+	}
+	ret, _ := rc.Message.GetRoundChangeJustifications()
+	return ret
+}
@@ -84,6 +84,14 @@ var AllTests = []tests.TestF{
 	proposer.SevenOperators,
 	proposer.TenOperators,
 	proposer.ThirteenOperators,
+	proposer.SeededRoundRobinAttester,
+	proposer.SeededRoundRobinProposer,
+	proposer.SeededRoundRobinOtherValidator,
+	proposer.Weighted,
+	proposer.WeightedNoWeights,
+	proposer.SkipOffline,
+	proposer.SkipOfflineConsecutive,
+	proposer.SkipOfflineAll,
 
 	messages.RoundChangePrePreparedJustifications,
 	messages.RoundChangeNotPreparedJustifications,
@@ -120,6 +128,8 @@ var AllTests = []tests.TestF{
 	messages.MarshalJustifications,
 	messages.UnmarshalJustifications,
 	messages.ValidHashDataRoot,
+	messages.MsgSizeRoundChange,
+	messages.MsgSizeProposal,
 
 	tests.HappyFlow,
 	tests.SevenOperators,
@@ -159,6 +169,7 @@ var AllTests = []tests.TestF{
 	proposal.UnknownSigner,
 	proposal.ForceStop,
 	proposal.PostCutoff,
+	proposal.MinimalJustification,
 
 	prepare.DuplicateMsg,
 	prepare.HappyFlow,
@@ -174,6 +185,7 @@ var AllTests = []tests.TestF{
 	prepare.PrepareQuorumTriggeredTwiceLateCommit,
 	prepare.ForceStop,
 	prepare.PostCutoff,
+	prepare.WeightedNoQuorum,
 
 	commit.CurrentRound,
 	commit.FutureRound,
@@ -194,6 +206,8 @@ var AllTests = []tests.TestF{
 	commit.NoCommitQuorum,
 	commit.ForceStop,
 	commit.PostCutoff,
+	commit.WeightedQuorum,
+	commit.WeightedNoQuorum,
 
 	roundchange.HappyFlow,
 	roundchange.WrongHeight,
@@ -234,4 +248,10 @@ var AllTests = []tests.TestF{
 	roundchange.EmptySigners,
 	roundchange.NonUniqueSigner,
 	roundchange.ZeroSigner,
+	roundchange.ValidJustificationFullData,
+	roundchange.InvalidJustificationFullData,
+	roundchange.QuorumPreparedRootOnly,
+	roundchange.QuorumPreparedUnknownValue,
+	roundchange.MinimalJustification,
+	roundchange.MinimalJustificationSevenOperators,
 }
@@ -95,6 +95,22 @@ func TestJson(t *testing.T) {
 				typedTest := &tests2.RoundRobinSpecTest{}
 				require.NoError(t, json.Unmarshal(byts, &typedTest))
 
+				tests[testName] = typedTest
+				typedTest.Run(t)
+			case reflect.TypeOf(&tests2.ProposerSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &tests2.ProposerSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+
+				tests[testName] = typedTest
+				typedTest.Run(t)
+			case reflect.TypeOf(&tests2.MsgSizeSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &tests2.MsgSizeSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+
 				tests[testName] = typedTest
 				typedTest.Run(t)
 			case reflect.TypeOf(&timeout.SpecTest{}).String():
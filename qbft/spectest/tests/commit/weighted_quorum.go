@@ -0,0 +1,73 @@
+package commit
+
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// WeightedQuorum tests a quorum of commits by voting power (weights [2,1,1,1,1,1,1], quorum 6 of 8) including the heavy operator.
+// 5 operators decide, safe since any two quorums intersect with 2*6-8 = 4 > f = 2 voting power
+func WeightedQuorum() tests.SpecTest {
+	pre := testingutils.WeightedInstance()
+	ks := testingutils.Testing7SharesSet()
+
+	msgs := []*qbft.SignedMessage{
+		testingutils.TestingProposalMessage(ks.Shares[1], 1),
+
+		testingutils.TestingPrepareMessage(ks.Shares[1], 1),
+		testingutils.TestingPrepareMessage(ks.Shares[2], 2),
+		testingutils.TestingPrepareMessage(ks.Shares[3], 3),
+		testingutils.TestingPrepareMessage(ks.Shares[4], 4),
+		testingutils.TestingPrepareMessage(ks.Shares[5], 5),
+
+		testingutils.TestingCommitMessage(ks.Shares[1], 1),
+		testingutils.TestingCommitMessage(ks.Shares[2], 2),
+		testingutils.TestingCommitMessage(ks.Shares[3], 3),
+		testingutils.TestingCommitMessage(ks.Shares[4], 4),
+		testingutils.TestingCommitMessage(ks.Shares[5], 5),
+	}
+	return &tests.MsgProcessingSpecTest{
+		Name:          "commit weighted quorum",
+		Pre:           pre,
+		PostRoot:      "dc652fdddfc1b0413b91b0ca27545a7555bd9f17f31b364b9cbd872e63bdff5c",
+		InputMessages: msgs,
+		OutputMessages: []*qbft.SignedMessage{
+			testingutils.TestingPrepareMessage(ks.Shares[1], 1),
+			testingutils.TestingCommitMessage(ks.Shares[1], 1),
+		},
+	}
+}
+
+// WeightedNoQuorum tests 5 light operators' commits don't have quorum by voting power (weights [2,1,1,1,1,1,1], 5 < quorum 6).
+// With equal voting power 5 of 7 commits would decide
+func WeightedNoQuorum() tests.SpecTest {
+	pre := testingutils.WeightedInstance()
+	ks := testingutils.Testing7SharesSet()
+
+	msgs := []*qbft.SignedMessage{
+		testingutils.TestingProposalMessage(ks.Shares[1], 1),
+
+		testingutils.TestingPrepareMessage(ks.Shares[1], 1),
+		testingutils.TestingPrepareMessage(ks.Shares[2], 2),
+		testingutils.TestingPrepareMessage(ks.Shares[3], 3),
+		testingutils.TestingPrepareMessage(ks.Shares[4], 4),
+		testingutils.TestingPrepareMessage(ks.Shares[5], 5),
+
+		testingutils.TestingCommitMessage(ks.Shares[2], 2),
+		testingutils.TestingCommitMessage(ks.Shares[3], 3),
+		testingutils.TestingCommitMessage(ks.Shares[4], 4),
+		testingutils.TestingCommitMessage(ks.Shares[5], 5),
+		testingutils.TestingCommitMessage(ks.Shares[6], 6),
+	}
+	return &tests.MsgProcessingSpecTest{
+		Name:          "commit weighted no quorum",
+		Pre:           pre,
+		PostRoot:      "235a749e725571894969e0a39a5680a38c7e55773b1005803efd6ef97ddf9ede",
+		InputMessages: msgs,
+		OutputMessages: []*qbft.SignedMessage{
+			testingutils.TestingPrepareMessage(ks.Shares[1], 1),
+			testingutils.TestingCommitMessage(ks.Shares[1], 1),
+		},
+	}
+}
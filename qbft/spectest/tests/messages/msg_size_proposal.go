@@ -0,0 +1,52 @@
+package messages
+
+import (
+	spec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// MsgSizeProposal tests the size of a created proposal justified by prepared round changes vs the previous format where the
+// round changes attached the prepared full data.
+// Justifications never carried full data (see qbft.MarshalJustifications), so the proposal size is unchanged
+func MsgSizeProposal() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	fullData := testingutils.TestProposerConsensusDataBytsV(spec.DataVersionCapella)
+
+	rcMsgs := make([]*qbft.SignedMessage, 0)
+	previousRcMsgs := make([]*qbft.SignedMessage, 0)
+	for id := types.OperatorID(1); id <= 3; id++ {
+		rc := createPreparedRoundChange(ks, id, fullData)
+		previous := *rc
+		previous.FullData = fullData
+
+		rcMsgs = append(rcMsgs, rc)
+		previousRcMsgs = append(previousRcMsgs, &previous)
+	}
+
+	return &tests.MsgSizeSpecTest{
+		Name:                 "proposal",
+		Message:              createProposal(ks, fullData, rcMsgs),
+		PreviousMessage:      createProposal(ks, fullData, previousRcMsgs),
+		ExpectedSize:         11495,
+		ExpectedPreviousSize: 11495,
+	}
+}
+
+// createProposal returns the second round proposal for fullData justified by the round changes
+func createProposal(ks *testingutils.TestKeySet, fullData []byte, roundChanges []*qbft.SignedMessage) *qbft.SignedMessage {
+	state := &qbft.State{
+		Share:  testingutils.TestingShare(ks),
+		ID:     testingutils.TestingIdentifier,
+		Height: qbft.FirstHeight,
+		Round:  2,
+	}
+
+	msg, err := qbft.CreateProposal(state, testingutils.TestingConfig(ks), fullData, roundChanges, preparesForFullData(ks, fullData))
+	if err != nil {
+		panic(err.Error())
+	}
+	return msg
+}
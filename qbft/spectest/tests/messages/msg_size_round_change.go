@@ -0,0 +1,67 @@
+package messages
+
+import (
+	"crypto/sha256"
+
+	spec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// MsgSizeRoundChange tests the size of a created prepared round change (carrying only the prepared root) vs the previous
+// format attaching the prepared full data
+func MsgSizeRoundChange() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	fullData := testingutils.TestProposerConsensusDataBytsV(spec.DataVersionCapella)
+
+	msg := createPreparedRoundChange(ks, types.OperatorID(1), fullData)
+	previous := *msg
+	previous.FullData = fullData
+
+	return &tests.MsgSizeSpecTest{
+		Name:                 "round change",
+		Message:              msg,
+		PreviousMessage:      &previous,
+		ExpectedSize:         796,
+		ExpectedPreviousSize: 9095,
+	}
+}
+
+// createPreparedRoundChange returns the round change created by the operator after preparing fullData in the first round
+func createPreparedRoundChange(ks *testingutils.TestKeySet, id types.OperatorID, fullData []byte) *qbft.SignedMessage {
+	share := testingutils.TestingShare(ks)
+	share.OperatorID = id
+	share.SharePubKey = ks.Shares[id].GetPublicKey().Serialize()
+
+	state := &qbft.State{
+		Share:             share,
+		ID:                testingutils.TestingIdentifier,
+		Height:            qbft.FirstHeight,
+		LastPreparedRound: qbft.FirstRound,
+		LastPreparedValue: fullData,
+		PrepareContainer:  qbft.NewMsgContainer(),
+	}
+	for _, msg := range preparesForFullData(ks, fullData) {
+		if _, err := state.PrepareContainer.AddFirstMsgForSignerAndRound(msg); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	msg, err := qbft.CreateRoundChange(state, testingutils.TestingConfig(ks), 2, fullData)
+	if err != nil {
+		panic(err.Error())
+	}
+	return msg
+}
+
+// preparesForFullData returns a prepare quorum for fullData in the first round
+func preparesForFullData(ks *testingutils.TestKeySet, fullData []byte) []*qbft.SignedMessage {
+	root := sha256.Sum256(fullData)
+	return []*qbft.SignedMessage{
+		testingutils.TestingPrepareMessageWithParams(ks.Shares[1], types.OperatorID(1), qbft.FirstRound, qbft.FirstHeight, testingutils.TestingIdentifier, root),
+		testingutils.TestingPrepareMessageWithParams(ks.Shares[2], types.OperatorID(2), qbft.FirstRound, qbft.FirstHeight, testingutils.TestingIdentifier, root),
+		testingutils.TestingPrepareMessageWithParams(ks.Shares[3], types.OperatorID(3), qbft.FirstRound, qbft.FirstHeight, testingutils.TestingIdentifier, root),
+	}
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/stretchr/testify/require"
+)
+
+// MsgSizeSpecTest tests the encoded size of a created message, compared to the same message in the previous format where
+// round changes attached the prepared full data
+type MsgSizeSpecTest struct {
+	Name                 string
+	Message              *qbft.SignedMessage
+	PreviousMessage      *qbft.SignedMessage
+	ExpectedSize         int
+	ExpectedPreviousSize int
+}
+
+func (test *MsgSizeSpecTest) Run(t *testing.T) {
+	require.NoError(t, test.Message.Validate())
+	require.NoError(t, test.PreviousMessage.Validate())
+
+	byts, err := test.Message.Encode()
+	require.NoError(t, err)
+	previousByts, err := test.PreviousMessage.Encode()
+	require.NoError(t, err)
+
+	require.EqualValues(t, test.ExpectedSize, len(byts))
+	require.EqualValues(t, test.ExpectedPreviousSize, len(previousByts))
+	require.LessOrEqual(t, len(byts), len(previousByts))
+}
+
+func (test *MsgSizeSpecTest) TestName() string {
+	return "qbft message size " + test.Name
+}
+
+func (test *MsgSizeSpecTest) GetPostState() (interface{}, error) {
+	return nil, nil
+}
@@ -0,0 +1,33 @@
+package prepare
+
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// WeightedNoQuorum tests 5 light operators' prepares don't have quorum by voting power (weights [2,1,1,1,1,1,1], 5 < quorum 6),
+// no commit is sent
+func WeightedNoQuorum() tests.SpecTest {
+	pre := testingutils.WeightedInstance()
+	ks := testingutils.Testing7SharesSet()
+
+	msgs := []*qbft.SignedMessage{
+		testingutils.TestingProposalMessage(ks.Shares[1], 1),
+
+		testingutils.TestingPrepareMessage(ks.Shares[2], 2),
+		testingutils.TestingPrepareMessage(ks.Shares[3], 3),
+		testingutils.TestingPrepareMessage(ks.Shares[4], 4),
+		testingutils.TestingPrepareMessage(ks.Shares[5], 5),
+		testingutils.TestingPrepareMessage(ks.Shares[6], 6),
+	}
+	return &tests.MsgProcessingSpecTest{
+		Name:          "prepare weighted no quorum",
+		Pre:           pre,
+		PostRoot:      "cef8e02e09c62cfea1a41262c29ab2d25acd17534a2f0fdb7b06d28ad1798de3",
+		InputMessages: msgs,
+		OutputMessages: []*qbft.SignedMessage{
+			testingutils.TestingPrepareMessage(ks.Shares[1], 1),
+		},
+	}
+}
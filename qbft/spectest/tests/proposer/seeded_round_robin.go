@@ -0,0 +1,59 @@
+package proposer
+
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// SeededRoundRobinAttester tests seeded round-robin proposer selection for an attester instance, the identifier seeds
+// the first height's proposer to operator 2
+func SeededRoundRobinAttester() tests.SpecTest {
+	id := types.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingValidatorPubKey[:], types.BNRoleAttester)
+	return seededRoundRobinTest("seeded round robin attester", id[:], 1)
+}
+
+// SeededRoundRobinProposer tests seeded round-robin proposer selection for a proposer instance of the same validator,
+// the identifier seeds the first height's proposer to operator 3
+func SeededRoundRobinProposer() tests.SpecTest {
+	id := types.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingValidatorPubKey[:], types.BNRoleProposer)
+	return seededRoundRobinTest("seeded round robin proposer", id[:], 2)
+}
+
+// SeededRoundRobinOtherValidator tests seeded round-robin proposer selection for an attester instance of another validator
+// with the same committee, the identifier seeds the first height's proposer to operator 4
+func SeededRoundRobinOtherValidator() tests.SpecTest {
+	id := types.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingWrongValidatorPubKey[:], types.BNRoleAttester)
+	return seededRoundRobinTest("seeded round robin other validator", id[:], 3)
+}
+
+func seededRoundRobinTest(name string, identifier []byte, offset uint64) tests.SpecTest {
+	var p types.OperatorID
+	heights := make([]qbft.Height, 0)
+	rounds := make([]qbft.Round, 0)
+	proposers := make([]types.OperatorID, 0)
+	for h := qbft.FirstHeight; h < 20; h++ {
+		p = types.OperatorID((uint64(h)+offset)%4) + 1
+		for r := qbft.FirstRound; r < 20; r++ {
+			heights = append(heights, h)
+			rounds = append(rounds, r)
+			proposers = append(proposers, p)
+
+			p++
+			if p == 5 {
+				p = 1
+			}
+		}
+	}
+
+	return &tests.ProposerSpecTest{
+		Name:       name,
+		Strategy:   tests.SeededRoundRobinStrategy,
+		Share:      testingutils.TestingShare(testingutils.Testing4SharesSet()),
+		Identifier: identifier,
+		Heights:    heights,
+		Rounds:     rounds,
+		Proposers:  proposers,
+	}
+}
@@ -0,0 +1,66 @@
+package proposer
+
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// SkipOffline tests round-robin proposer selection skipping offline operators, operator 3 is selected instead of offline operator 2
+func SkipOffline() tests.SpecTest {
+	return skipOfflineTest("skip offline", []types.OperatorID{2}, func(p types.OperatorID) types.OperatorID {
+		if p == 2 {
+			return 3
+		}
+		return p
+	})
+}
+
+// SkipOfflineConsecutive tests round-robin proposer selection skipping consecutive offline operators, operator 4 is
+// selected instead of offline operators 2 and 3
+func SkipOfflineConsecutive() tests.SpecTest {
+	return skipOfflineTest("skip offline consecutive", []types.OperatorID{2, 3}, func(p types.OperatorID) types.OperatorID {
+		if p == 2 || p == 3 {
+			return 4
+		}
+		return p
+	})
+}
+
+// SkipOfflineAll tests round-robin proposer selection when all operators are offline, the round-robin proposer is selected
+func SkipOfflineAll() tests.SpecTest {
+	return skipOfflineTest("skip offline all", []types.OperatorID{1, 2, 3, 4}, func(p types.OperatorID) types.OperatorID {
+		return p
+	})
+}
+
+func skipOfflineTest(name string, offline []types.OperatorID, expected func(types.OperatorID) types.OperatorID) tests.SpecTest {
+	var p types.OperatorID
+	heights := make([]qbft.Height, 0)
+	rounds := make([]qbft.Round, 0)
+	proposers := make([]types.OperatorID, 0)
+	for h := qbft.FirstHeight; h < 20; h++ {
+		p = types.OperatorID(h%4) + 1
+		for r := qbft.FirstRound; r < 20; r++ {
+			heights = append(heights, h)
+			rounds = append(rounds, r)
+			proposers = append(proposers, expected(p))
+
+			p++
+			if p == 5 {
+				p = 1
+			}
+		}
+	}
+
+	return &tests.ProposerSpecTest{
+		Name:      name,
+		Strategy:  tests.SkipOfflineStrategy,
+		Share:     testingutils.TestingShare(testingutils.Testing4SharesSet()),
+		Offline:   offline,
+		Heights:   heights,
+		Rounds:    rounds,
+		Proposers: proposers,
+	}
+}
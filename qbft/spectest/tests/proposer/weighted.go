@@ -0,0 +1,73 @@
+package proposer
+
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// Weighted tests weighted proposer selection, operator 1 has no weight and is never selected
+func Weighted() tests.SpecTest {
+	id := types.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingValidatorPubKey[:], types.BNRoleAttester)
+
+	heights := make([]qbft.Height, 0)
+	rounds := make([]qbft.Round, 0)
+	for h := qbft.FirstHeight; h < 5; h++ {
+		for r := qbft.FirstRound; r < 5; r++ {
+			heights = append(heights, h)
+			rounds = append(rounds, r)
+		}
+	}
+
+	return &tests.ProposerSpecTest{
+		Name:       "weighted",
+		Strategy:   tests.WeightedStrategy,
+		Share:      testingutils.TestingShare(testingutils.Testing4SharesSet()),
+		Identifier: id[:],
+		Weights:    map[types.OperatorID]uint64{2: 1, 3: 1, 4: 2},
+		Heights:    heights,
+		Rounds:     rounds,
+		Proposers: []types.OperatorID{
+			4, 3, 2, 4,
+			3, 4, 4, 4,
+			4, 4, 2, 3,
+			4, 4, 3, 3,
+			3, 3, 2, 4,
+		},
+	}
+}
+
+// WeightedNoWeights tests weighted proposer selection without weights falls back to round-robin
+func WeightedNoWeights() tests.SpecTest {
+	id := types.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingValidatorPubKey[:], types.BNRoleAttester)
+
+	var p types.OperatorID
+	heights := make([]qbft.Height, 0)
+	rounds := make([]qbft.Round, 0)
+	proposers := make([]types.OperatorID, 0)
+	for h := qbft.FirstHeight; h < 20; h++ {
+		p = types.OperatorID(h%4) + 1
+		for r := qbft.FirstRound; r < 20; r++ {
+			heights = append(heights, h)
+			rounds = append(rounds, r)
+			proposers = append(proposers, p)
+
+			p++
+			if p == 5 {
+				p = 1
+			}
+		}
+	}
+
+	return &tests.ProposerSpecTest{
+		Name:       "weighted no weights",
+		Strategy:   tests.WeightedStrategy,
+		Share:      testingutils.TestingShare(testingutils.Testing4SharesSet()),
+		Identifier: id[:],
+		Weights:    map[types.OperatorID]uint64{},
+		Heights:    heights,
+		Rounds:     rounds,
+		Proposers:  proposers,
+	}
+}
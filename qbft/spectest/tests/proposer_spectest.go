@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	SeededRoundRobinStrategy = "seeded round robin"
+	WeightedStrategy         = "weighted"
+	SkipOfflineStrategy      = "skip offline"
+)
+
+// ProposerSpecTest tests the proposer selected by a proposer strategy for each height and round is deterministic
+type ProposerSpecTest struct {
+	Name       string
+	Strategy   string
+	Share      *types.Share
+	Identifier []byte
+	// Weights are the operators' weights for the weighted strategy
+	Weights map[types.OperatorID]uint64
+	// Offline are the offline operators for the skip offline strategy (over round robin)
+	Offline   []types.OperatorID
+	Heights   []qbft.Height
+	Rounds    []qbft.Round
+	Proposers []types.OperatorID
+}
+
+func (test *ProposerSpecTest) Run(t *testing.T) {
+	proposerF := test.proposerF(t)
+
+	require.True(t, len(test.Heights) > 0)
+	for i, h := range test.Heights {
+		r := test.Rounds[i]
+		s := &qbft.State{
+			Share:  test.Share,
+			ID:     test.Identifier,
+			Height: h,
+			Round:  r,
+		}
+
+		require.EqualValues(t, test.Proposers[i], proposerF(s, r), "height %d round %d", h, r)
+		require.EqualValues(t, proposerF(s, r), proposerF(s, r))
+	}
+}
+
+func (test *ProposerSpecTest) proposerF(t *testing.T) qbft.ProposerF {
+	switch test.Strategy {
+	case SeededRoundRobinStrategy:
+		return qbft.SeededRoundRobinProposer
+	case WeightedStrategy:
+		return qbft.WeightedProposerF(test.Weights)
+	case SkipOfflineStrategy:
+		offline := make(map[types.OperatorID]bool)
+		for _, id := range test.Offline {
+			offline[id] = true
+		}
+		return qbft.SkipOfflineProposerF(qbft.RoundRobinProposer, func(operatorID types.OperatorID) bool {
+			return offline[operatorID]
+		})
+	default:
+		require.Fail(t, "unknown proposer strategy")
+		return nil
+	}
+}
+
+func (test *ProposerSpecTest) TestName() string {
+	return "qbft proposer " + test.Name
+}
+
+func (test *ProposerSpecTest) GetPostState() (interface{}, error) {
+	return nil, nil
+}
@@ -13,6 +13,8 @@ func DuplicateMsgQuorumPreparedRCFirst() tests.SpecTest {
 	pre := testingutils.BaseInstance()
 	pre.State.Round = 2
 	ks := testingutils.Testing4SharesSet()
+	// the leader received the proposal, round changes carry only the prepared root
+	pre.State.ProposeContainer.AddMsg(testingutils.TestingProposalMessage(ks.Shares[1], types.OperatorID(1)))
 	sc := duplicateMsgQuorumPreparedRCFirstStateComparison()
 
 	prepareMsgs := []*qbft.SignedMessage{
@@ -71,6 +73,6 @@ func duplicateMsgQuorumPreparedRCFirstStateComparison() *comparable.StateCompari
 			Round: 2,
 		},
 	}
-	comparable.SetSignedMessages(instance, msgs)
+	comparable.SetSignedMessages(instance, append([]*qbft.SignedMessage{testingutils.TestingProposalMessage(ks.Shares[1], types.OperatorID(1))}, msgs...))
 	return &comparable.StateComparison{ExpectedState: instance.State}
 }
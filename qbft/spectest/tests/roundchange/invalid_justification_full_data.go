@@ -1,8 +1,35 @@
 package roundchange
 
-import "github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
 
 // InvalidJustificationFullData tests round change justification for which H(full data) != root
 func InvalidJustificationFullData() tests.SpecTest {
-	panic("implement")
+	pre := testingutils.BaseInstance()
+	pre.State.Round = 2
+	ks := testingutils.Testing4SharesSet()
+
+	prepareMsgs := []*qbft.SignedMessage{
+		testingutils.TestingPrepareMessage(ks.Shares[1], types.OperatorID(1)),
+		testingutils.TestingPrepareMessage(ks.Shares[2], types.OperatorID(2)),
+		testingutils.TestingPrepareMessage(ks.Shares[3], types.OperatorID(3)),
+	}
+	msgs := []*qbft.SignedMessage{
+		testingutils.TestingRoundChangeMessageWithParamsAndFullData(ks.Shares[2], types.OperatorID(2), 2, qbft.FirstHeight,
+			testingutils.TestingQBFTRootData, qbft.FirstRound, []byte{1, 2, 3, 4},
+			testingutils.MarshalJustifications(prepareMsgs)),
+	}
+
+	return &tests.MsgProcessingSpecTest{
+		Name:           "round change justification invalid full data",
+		Pre:            pre,
+		PostRoot:       "96e6d7bdbb98a2d9937f3d97d6aa096bd3a58f923b61012048ac70ad52765919",
+		InputMessages:  msgs,
+		OutputMessages: []*qbft.SignedMessage{},
+		ExpectedError:  "invalid signed message: H(data) != root",
+	}
 }
@@ -0,0 +1,44 @@
+package roundchange
+
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// MinimalJustification tests the proposal created for a prepared round change quorum carries only a quorum of prepares
+// although the round changes are justified by all the committee's prepares
+func MinimalJustification() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	pre := testingutils.BaseInstance()
+	pre.State.Round = 2
+	// the leader received the proposal, round changes carry only the prepared root
+	pre.State.ProposeContainer.AddMsg(testingutils.TestingProposalMessage(ks.Shares[1], types.OperatorID(1)))
+
+	prepareMsgs := []*qbft.SignedMessage{
+		testingutils.TestingPrepareMessage(ks.Shares[1], types.OperatorID(1)),
+		testingutils.TestingPrepareMessage(ks.Shares[2], types.OperatorID(2)),
+		testingutils.TestingPrepareMessage(ks.Shares[3], types.OperatorID(3)),
+		testingutils.TestingPrepareMessage(ks.Shares[4], types.OperatorID(4)),
+	}
+	msgs := []*qbft.SignedMessage{
+		testingutils.TestingRoundChangeMessageWithRoundAndRC(ks.Shares[2], types.OperatorID(2), 2,
+			testingutils.MarshalJustifications(prepareMsgs)),
+		testingutils.TestingRoundChangeMessageWithRound(ks.Shares[3], types.OperatorID(3), 2),
+		testingutils.TestingRoundChangeMessageWithRoundAndRC(ks.Shares[4], types.OperatorID(4), 2,
+			testingutils.MarshalJustifications(prepareMsgs)),
+	}
+
+	return &tests.MsgProcessingSpecTest{
+		Name:          "round change minimal justification",
+		Pre:           pre,
+		PostRoot:      "b30a5a5ef106f7cd62f70568f0194f51b49a50c0ba12e30b29d0c8194d4df169",
+		InputMessages: msgs,
+		OutputMessages: []*qbft.SignedMessage{
+			testingutils.TestingProposalMessageWithParams(ks.Shares[1], types.OperatorID(1), 2, qbft.FirstHeight,
+				testingutils.TestingQBFTRootData,
+				testingutils.MarshalJustifications(msgs), testingutils.MarshalJustifications(prepareMsgs[:3])),
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package roundchange
+
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// MinimalJustificationSevenOperators tests the proposal created for a prepared round change quorum of a 7 operators
+// committee carries only a quorum of prepares
+func MinimalJustificationSevenOperators() tests.SpecTest {
+	ks := testingutils.Testing7SharesSet()
+	pre := testingutils.SevenOperatorsInstance()
+	pre.State.Round = 2
+	// the leader received the proposal, round changes carry only the prepared root
+	pre.State.ProposeContainer.AddMsg(testingutils.TestingProposalMessage(ks.Shares[1], types.OperatorID(1)))
+
+	prepareMsgs := make([]*qbft.SignedMessage, 0)
+	for id := types.OperatorID(1); id <= 7; id++ {
+		prepareMsgs = append(prepareMsgs, testingutils.TestingPrepareMessage(ks.Shares[id], id))
+	}
+	msgs := []*qbft.SignedMessage{
+		testingutils.TestingRoundChangeMessageWithRound(ks.Shares[2], types.OperatorID(2), 2),
+		testingutils.TestingRoundChangeMessageWithRoundAndRC(ks.Shares[3], types.OperatorID(3), 2,
+			testingutils.MarshalJustifications(prepareMsgs)),
+		testingutils.TestingRoundChangeMessageWithRound(ks.Shares[4], types.OperatorID(4), 2),
+		testingutils.TestingRoundChangeMessageWithRound(ks.Shares[5], types.OperatorID(5), 2),
+		testingutils.TestingRoundChangeMessageWithRoundAndRC(ks.Shares[6], types.OperatorID(6), 2,
+			testingutils.MarshalJustifications(prepareMsgs)),
+	}
+
+	return &tests.MsgProcessingSpecTest{
+		Name:          "round change minimal justification seven operators",
+		Pre:           pre,
+		PostRoot:      "a186c5c21b6498285914aded63d1f11c4eb30edcdeccdc311803f1c18be11659",
+		InputMessages: msgs,
+		OutputMessages: []*qbft.SignedMessage{
+			testingutils.TestingProposalMessageWithParams(ks.Shares[1], types.OperatorID(1), 2, qbft.FirstHeight,
+				testingutils.TestingQBFTRootData,
+				testingutils.MarshalJustifications(msgs), testingutils.MarshalJustifications(prepareMsgs[:5])),
+		},
+	}
+}
@@ -12,6 +12,8 @@ func PeerPrepared() tests.SpecTest {
 	ks := testingutils.Testing4SharesSet()
 	pre := testingutils.BaseInstance()
 	pre.State.Round = 2
+	// the leader received the proposal, round changes carry only the prepared root
+	pre.State.ProposeContainer.AddMsg(testingutils.TestingProposalMessage(ks.Shares[1], types.OperatorID(1)))
 
 	prepareMsgs := []*qbft.SignedMessage{
 		testingutils.TestingPrepareMessage(ks.Shares[1], types.OperatorID(1)),
@@ -28,7 +30,7 @@ func PeerPrepared() tests.SpecTest {
 	return &tests.MsgProcessingSpecTest{
 		Name:          "round change peer prepared",
 		Pre:           pre,
-		PostRoot:      "6eb5843e6defd9e72f09a82e0da584516ded7e357aa708836b85bc02cfe6a0b4",
+		PostRoot:      "b2402ebae23d23dcb0ec91a5ea3b345e4c614fd81af118c6ff4981bbb9d5d3f4",
 		InputMessages: msgs,
 		OutputMessages: []*qbft.SignedMessage{
 			testingutils.TestingProposalMessageWithParams(ks.Shares[1], types.OperatorID(1), 2, qbft.FirstHeight,
@@ -13,6 +13,8 @@ func PeerPreparedDifferentHeights() tests.SpecTest {
 	pre.State.Round = 3
 
 	ks := testingutils.Testing4SharesSet()
+	// the leader received the proposal, round changes carry only the prepared root
+	pre.State.ProposeContainer.AddMsg(testingutils.TestingProposalMessage(ks.Shares[1], types.OperatorID(1)))
 	prepareMsgs1 := []*qbft.SignedMessage{
 		testingutils.TestingPrepareMessage(ks.Shares[1], types.OperatorID(1)),
 		testingutils.TestingPrepareMessage(ks.Shares[2], types.OperatorID(2)),
@@ -35,7 +37,7 @@ func PeerPreparedDifferentHeights() tests.SpecTest {
 	return &tests.MsgProcessingSpecTest{
 		Name:          "round change peer prepared different heights",
 		Pre:           pre,
-		PostRoot:      "802b2c5b3e85312a7068a28a22d91f0af050c2bf17993730896929cd40f50195",
+		PostRoot:      "d4f4bb7d68a4d02fc2474185bbb86011481f78b41670f96fa173ebae6215ed8d",
 		InputMessages: msgs,
 		OutputMessages: []*qbft.SignedMessage{
 			testingutils.TestingProposalMessageWithParams(ks.Shares[1], types.OperatorID(1), 3, qbft.FirstHeight,
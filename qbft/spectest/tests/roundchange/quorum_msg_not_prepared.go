@@ -13,6 +13,8 @@ func QuorumMsgNotPrepared() tests.SpecTest {
 	ks := testingutils.Testing4SharesSet()
 	pre := testingutils.BaseInstance()
 	pre.State.Round = 2
+	// the leader received the proposal, round changes carry only the prepared root
+	pre.State.ProposeContainer.AddMsg(testingutils.TestingProposalMessage(ks.Shares[1], types.OperatorID(1)))
 	sc := quorumMsgNotPreparedStateComparison()
 
 	prepareMsgs := []*qbft.SignedMessage{
@@ -64,6 +66,6 @@ func quorumMsgNotPreparedStateComparison() *comparable.StateComparison {
 			Round: 2,
 		},
 	}
-	comparable.SetSignedMessages(instance, msgs)
+	comparable.SetSignedMessages(instance, append([]*qbft.SignedMessage{testingutils.TestingProposalMessage(ks.Shares[1], types.OperatorID(1))}, msgs...))
 	return &comparable.StateComparison{ExpectedState: instance.State}
 }
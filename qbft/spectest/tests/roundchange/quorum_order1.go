@@ -15,6 +15,8 @@ func QuorumOrder1() tests.SpecTest {
 	sc := quorumOrder1StateComparison()
 
 	ks := testingutils.Testing4SharesSet()
+	// the leader received the proposal, round changes carry only the prepared root
+	pre.State.ProposeContainer.AddMsg(testingutils.TestingProposalMessage(ks.Shares[1], types.OperatorID(1)))
 	prepareMsgs := []*qbft.SignedMessage{
 		testingutils.TestingPrepareMessage(ks.Shares[1], types.OperatorID(1)),
 		testingutils.TestingPrepareMessage(ks.Shares[3], types.OperatorID(3)),
@@ -64,6 +66,6 @@ func quorumOrder1StateComparison() *comparable.StateComparison {
 			Round: 2,
 		},
 	}
-	comparable.SetSignedMessages(instance, msgs)
+	comparable.SetSignedMessages(instance, append([]*qbft.SignedMessage{testingutils.TestingProposalMessage(ks.Shares[1], types.OperatorID(1))}, msgs...))
 	return &comparable.StateComparison{ExpectedState: instance.State}
 }
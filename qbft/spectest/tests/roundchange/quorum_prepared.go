@@ -13,6 +13,8 @@ func QuorumPrepared() tests.SpecTest {
 
 	pre := testingutils.BaseInstance()
 	pre.State.Round = 2
+	// the leader received the proposal, round changes carry only the prepared root
+	pre.State.ProposeContainer.AddMsg(testingutils.TestingProposalMessage(ks.Shares[1], types.OperatorID(1)))
 
 	prepareMsgs := []*qbft.SignedMessage{
 		testingutils.TestingPrepareMessage(ks.Shares[1], types.OperatorID(1)),
@@ -30,7 +32,7 @@ func QuorumPrepared() tests.SpecTest {
 	return &tests.MsgProcessingSpecTest{
 		Name:          "round change prepared",
 		Pre:           pre,
-		PostRoot:      "47d1625f0765ccbe185d0de05566ba4fa7ecd1eef6336b31cffb25f5adaef007",
+		PostRoot:      "b3076acc2c824701f2fd8a2ccff128c113218036b6b423de435e1486af8fe998",
 		InputMessages: msgs,
 		OutputMessages: []*qbft.SignedMessage{
 			testingutils.TestingProposalMessageWithParams(
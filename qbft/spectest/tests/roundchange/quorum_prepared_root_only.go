@@ -0,0 +1,45 @@
+package roundchange
+
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// QuorumPreparedRootOnly tests a prepared round change quorum carrying only the prepared root, the leader proposes its
+// locally prepared value
+func QuorumPreparedRootOnly() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+
+	pre := testingutils.BaseInstance()
+	pre.State.Round = 2
+	pre.State.LastPreparedRound = qbft.FirstRound
+	pre.State.LastPreparedValue = testingutils.TestingQBFTFullData
+
+	prepareMsgs := []*qbft.SignedMessage{
+		testingutils.TestingPrepareMessage(ks.Shares[1], types.OperatorID(1)),
+		testingutils.TestingPrepareMessage(ks.Shares[2], types.OperatorID(2)),
+		testingutils.TestingPrepareMessage(ks.Shares[3], types.OperatorID(3)),
+	}
+	msgs := []*qbft.SignedMessage{
+		testingutils.TestingRoundChangeMessageWithRoundAndRC(ks.Shares[1], types.OperatorID(1), 2,
+			testingutils.MarshalJustifications(prepareMsgs)).WithoutFUllData(),
+		testingutils.TestingRoundChangeMessageWithRoundAndRC(ks.Shares[2], types.OperatorID(2), 2,
+			testingutils.MarshalJustifications(prepareMsgs)).WithoutFUllData(),
+		testingutils.TestingRoundChangeMessageWithRoundAndRC(ks.Shares[3], types.OperatorID(3), 2,
+			testingutils.MarshalJustifications(prepareMsgs)).WithoutFUllData(),
+	}
+
+	return &tests.MsgProcessingSpecTest{
+		Name:          "round change prepared root only",
+		Pre:           pre,
+		PostRoot:      "54c94ad68dcd085d01f2cf42337a818825402c5d69f6301322662967e1363969",
+		InputMessages: msgs,
+		OutputMessages: []*qbft.SignedMessage{
+			testingutils.TestingProposalMessageWithParams(
+				ks.Shares[1], types.OperatorID(1), 2, qbft.FirstHeight, testingutils.TestingQBFTRootData,
+				testingutils.MarshalJustifications(msgs), testingutils.MarshalJustifications(prepareMsgs)),
+		},
+	}
+}
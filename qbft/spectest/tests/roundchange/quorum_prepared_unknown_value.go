@@ -0,0 +1,39 @@
+package roundchange
+
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// QuorumPreparedUnknownValue tests a prepared round change quorum carrying only the prepared root, the leader doesn't
+// know the prepared value and can't propose
+func QuorumPreparedUnknownValue() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+
+	pre := testingutils.BaseInstance()
+	pre.State.Round = 2
+
+	prepareMsgs := []*qbft.SignedMessage{
+		testingutils.TestingPrepareMessage(ks.Shares[1], types.OperatorID(1)),
+		testingutils.TestingPrepareMessage(ks.Shares[2], types.OperatorID(2)),
+		testingutils.TestingPrepareMessage(ks.Shares[3], types.OperatorID(3)),
+	}
+	msgs := []*qbft.SignedMessage{
+		testingutils.TestingRoundChangeMessageWithRoundAndRC(ks.Shares[2], types.OperatorID(2), 2,
+			testingutils.MarshalJustifications(prepareMsgs)).WithoutFUllData(),
+		testingutils.TestingRoundChangeMessageWithRoundAndRC(ks.Shares[3], types.OperatorID(3), 2,
+			testingutils.MarshalJustifications(prepareMsgs)).WithoutFUllData(),
+		testingutils.TestingRoundChangeMessageWithRoundAndRC(ks.Shares[4], types.OperatorID(4), 2,
+			testingutils.MarshalJustifications(prepareMsgs)).WithoutFUllData(),
+	}
+
+	return &tests.MsgProcessingSpecTest{
+		Name:           "round change prepared unknown value",
+		Pre:            pre,
+		PostRoot:       "1932e20646e188ccae5e69da7f5128517a9275ac2de755b3258ca00d12ea715d",
+		InputMessages:  msgs,
+		OutputMessages: []*qbft.SignedMessage{},
+	}
+}
@@ -1,8 +1,34 @@
 package roundchange
 
-import "github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/qbft/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
 
 // ValidJustificationFullData tests round change justification for which H(full data) == root
 func ValidJustificationFullData() tests.SpecTest {
-	panic("implement")
+	pre := testingutils.BaseInstance()
+	pre.State.Round = 2
+	ks := testingutils.Testing4SharesSet()
+
+	prepareMsgs := []*qbft.SignedMessage{
+		testingutils.TestingPrepareMessage(ks.Shares[1], types.OperatorID(1)),
+		testingutils.TestingPrepareMessage(ks.Shares[2], types.OperatorID(2)),
+		testingutils.TestingPrepareMessage(ks.Shares[3], types.OperatorID(3)),
+	}
+	msgs := []*qbft.SignedMessage{
+		testingutils.TestingRoundChangeMessageWithParamsAndFullData(ks.Shares[2], types.OperatorID(2), 2, qbft.FirstHeight,
+			testingutils.TestingQBFTRootData, qbft.FirstRound, testingutils.TestingQBFTFullData,
+			testingutils.MarshalJustifications(prepareMsgs)),
+	}
+
+	return &tests.MsgProcessingSpecTest{
+		Name:           "round change justification valid full data",
+		Pre:            pre,
+		PostRoot:       "941fbbc34f52be2644b51e049aa30c39cc5a84d27c965feaec69898dcd3c779a",
+		InputMessages:  msgs,
+		OutputMessages: []*qbft.SignedMessage{},
+	}
 }
@@ -16,6 +16,8 @@ type signing interface {
 	GetOperatorSigner() types.OperatorSigner
 	// GetSignatureDomainType returns the Domain type used for signatures
 	GetSignatureDomainType() types.DomainType
+	// GetSignatureCache returns the cache of verified BLS signatures, nil if none
+	GetSignatureCache() *types.BLSSignatureCache
 }
 
 type IConfig interface {
@@ -39,6 +41,8 @@ type Config struct {
 	ProposerF      ProposerF
 	Network        Network
 	Timer          Timer
+	// SignatureCache caches verified BLS signatures so they aren't verified again, nil if none
+	SignatureCache *types.BLSSignatureCache
 }
 
 // GetSigner returns a Signer instance
@@ -61,6 +65,11 @@ func (c *Config) GetSignatureDomainType() types.DomainType {
 	return c.Domain
 }
 
+// GetSignatureCache returns the cache of verified BLS signatures, nil if none
+func (c *Config) GetSignatureCache() *types.BLSSignatureCache {
+	return c.SignatureCache
+}
+
 // GetValueCheckF returns value check instance
 func (c *Config) GetValueCheckF() ProposedValueCheckF {
 	return c.ValueCheckF
@@ -6,9 +6,10 @@ import (
 )
 
 var (
-	quickTimeoutThreshold = Round(8)        //nolint
-	quickTimeout          = 2 * time.Second //nolint
-	slowTimeout           = 2 * time.Minute //nolint
+	// QuickTimeoutThreshold is the last round with a QuickTimeout, later rounds time out after SlowTimeout
+	QuickTimeoutThreshold = Round(8)
+	QuickTimeout          = 2 * time.Second
+	SlowTimeout           = 2 * time.Minute
 	// CutoffRound which round the instance should stop its timer and progress no further
 	CutoffRound = 15 // stop processing instances after 8*2+120*6 = 14.2 min (~ 2 epochs)
 )
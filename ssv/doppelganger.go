@@ -0,0 +1,123 @@
+package ssv
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// DoppelgangerProtection holds the state of a validator's doppelganger detection phase.
+// While the phase is in progress the validator follows the network but refuses to start duties that sign with the validator's key.
+// It's intended to run when the validator is (re)started, for example when its cluster is moved between operator nodes.
+type DoppelgangerProtection struct {
+	// Epochs is the length of the detection window, liveness is checked for the Epochs before StartEpoch.
+	// The window's own epochs aren't checked, the rest of the committee keeps the validator live during them
+	Epochs uint64
+	// StartEpoch is the epoch in which the detection phase started
+	StartEpoch phase0.Epoch
+	// NextEpochToCheck is the next epoch for which liveness should be queried
+	NextEpochToCheck phase0.Epoch
+	// Detected is set once a doppelganger was detected, the validator will not sign until protection is restarted
+	Detected bool
+	// Completed is set once the detection window passed without detecting a doppelganger
+	Completed bool
+}
+
+// doppelgangerProtectedRoles are the roles that produce slashable or on chain signatures and are refused during the detection phase.
+// Validator registrations are off chain and are allowed so relays keep the validator's fee recipient.
+var doppelgangerProtectedRoles = map[types.BeaconRole]bool{
+	types.BNRoleAttester:                  true,
+	types.BNRoleAggregator:                true,
+	types.BNRoleProposer:                  true,
+	types.BNRoleSyncCommittee:             true,
+	types.BNRoleSyncCommitteeContribution: true,
+	types.BNRoleVoluntaryExit:             true,
+}
+
+// InProgress returns true if the detection phase didn't complete yet or a doppelganger was detected
+func (d *DoppelgangerProtection) InProgress() bool {
+	return d.Detected || !d.Completed
+}
+
+// StartDoppelgangerProtection starts the doppelganger detection phase at the given epoch, lasting the given number of epochs.
+// The beacon node is queried for the validator's liveness in the previous epochs, a live validator means a doppelganger.
+func (v *Validator) StartDoppelgangerProtection(epoch phase0.Epoch, epochs uint64) error {
+	firstEpoch := phase0.Epoch(0)
+	if uint64(epoch) > epochs {
+		firstEpoch = epoch - phase0.Epoch(epochs)
+	}
+
+	v.Doppelganger = &DoppelgangerProtection{
+		Epochs:           epochs,
+		StartEpoch:       epoch,
+		NextEpochToCheck: firstEpoch,
+	}
+	return v.UpdateDoppelgangerProtection(epoch)
+}
+
+// UpdateDoppelgangerProtection should be called on every new epoch while the detection phase is in progress.
+// It checks the liveness of the past epochs before the detection window not checked yet and completes the phase once the
+// detection window passed.
+func (v *Validator) UpdateDoppelgangerProtection(epoch phase0.Epoch) error {
+	d := v.Doppelganger
+	if d == nil || d.Detected || d.Completed {
+		return nil
+	}
+
+	pk := phase0.BLSPubKey{}
+	copy(pk[:], v.Share.ValidatorPubKey)
+
+	for ; d.NextEpochToCheck < epoch && d.NextEpochToCheck < d.StartEpoch; d.NextEpochToCheck++ {
+		live, err := v.Beacon.GetValidatorLiveness(pk, d.NextEpochToCheck)
+		if err != nil {
+			return errors.Wrap(err, "could not get validator liveness")
+		}
+		if live {
+			d.Detected = true
+			return errors.Errorf("doppelganger detected, validator live in epoch %d", d.NextEpochToCheck)
+		}
+	}
+
+	if uint64(epoch) >= uint64(d.StartEpoch)+d.Epochs {
+		d.Completed = true
+	}
+	return nil
+}
+
+// validateDutyDoppelganger returns error if the duty can't start because the detection phase is in progress
+func (v *Validator) validateDutyDoppelganger(duty *types.Duty) error {
+	if v.Doppelganger == nil || !doppelgangerProtectedRoles[duty.Type] {
+		return nil
+	}
+	if v.Doppelganger.Detected {
+		return errors.New("doppelganger detected")
+	}
+	if !v.Doppelganger.Completed {
+		return errors.New("doppelganger protection in progress")
+	}
+	return nil
+}
+
+// watchDecidedForDoppelganger marks a doppelganger as detected if, during the detection phase, a valid decided message signed by this
+// operator arrives for a height this operator has no instance for. Such a message means this operator's share is signing somewhere else.
+// The decided message's signatures are verified first (qbft.ValidateDecided), any committee peer could otherwise forge one with this
+// operator as a signer and block the validator's duties.
+func (v *Validator) watchDecidedForDoppelganger(runner Runner, msg *qbft.SignedMessage) {
+	if v.Doppelganger == nil || !v.Doppelganger.InProgress() {
+		return
+	}
+
+	if !qbft.IsDecidedMsg(v.Share, msg) || !msg.CommonSigners([]types.OperatorID{v.Share.OperatorID}) {
+		return
+	}
+
+	controller := runner.GetBaseRunner().QBFTController
+	if controller == nil || controller.InstanceForHeight(msg.Message.Height) != nil {
+		return
+	}
+	if err := qbft.ValidateDecided(controller.GetConfig(), msg, v.Share); err != nil {
+		return
+	}
+	v.Doppelganger.Detected = true
+}
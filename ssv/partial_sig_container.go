@@ -9,8 +9,14 @@ import (
 
 type PartialSigContainer struct {
 	Signatures map[string]map[types.OperatorID][]byte
-	// Quorum is the number of min signatures needed for quorum
+	// Quorum is the number of min signatures needed for quorum (min voting power if Weights is set)
 	Quorum uint64
+	// Weights is the signers' voting power, nil if all signers have equal voting power (1)
+	Weights map[types.OperatorID]uint64 `json:",omitempty"`
+
+	// reconstructor caches the Lagrange coefficients of the committee's signer subsets, shared by the committee's runners.
+	// A new reconstructor reconstructs the signature if nil
+	reconstructor *types.SignatureReconstructor
 }
 
 func NewPartialSigContainer(quorum uint64) *PartialSigContainer {
@@ -20,6 +26,13 @@ func NewPartialSigContainer(quorum uint64) *PartialSigContainer {
 	}
 }
 
+// NewWeightedPartialSigContainer returns a container reaching quorum by the signers' voting power, nil weights is equivalent to NewPartialSigContainer
+func NewWeightedPartialSigContainer(quorum uint64, weights map[types.OperatorID]uint64) *PartialSigContainer {
+	ret := NewPartialSigContainer(quorum)
+	ret.Weights = weights
+	return ret
+}
+
 func (ps *PartialSigContainer) AddSignature(sigMsg *types.PartialSignatureMessage) {
 	if ps.Signatures[rootHex(sigMsg.SigningRoot)] == nil {
 		ps.Signatures[rootHex(sigMsg.SigningRoot)] = make(map[types.OperatorID][]byte)
@@ -68,19 +81,30 @@ func (ps *PartialSigContainer) Remove(signer uint64, signingRoot [32]byte) {
 }
 
 func (ps *PartialSigContainer) ReconstructSignature(root [32]byte, validatorPubKey []byte) ([]byte, error) {
-	// Reconstruct signatures
-	signature, err := types.ReconstructSignatures(ps.Signatures[rootHex(root)])
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to reconstruct signatures")
+	reconstructor := ps.reconstructor
+	if reconstructor == nil {
+		reconstructor = types.NewSignatureReconstructor()
 	}
-	if err := types.VerifyReconstructedSignature(signature, validatorPubKey, root); err != nil {
-		return nil, errors.Wrap(err, "failed to verify reconstruct signature")
+
+	// Reconstruct signatures and verify the reconstructed signature
+	signature, err := reconstructor.ReconstructAndVerify(ps.Signatures[rootHex(root)], validatorPubKey, root)
+	if err != nil {
+		return nil, err
 	}
 	return signature.Serialize(), nil
 }
 
+// HasQuorum returns true if the signers for the root have quorum (by voting power if Weights is set)
 func (ps *PartialSigContainer) HasQuorum(root [32]byte) bool {
-	return uint64(len(ps.Signatures[rootHex(root)])) >= ps.Quorum
+	if ps.Weights == nil {
+		return uint64(len(ps.Signatures[rootHex(root)])) >= ps.Quorum
+	}
+
+	weight := uint64(0)
+	for signer := range ps.Signatures[rootHex(root)] {
+		weight += ps.Weights[signer]
+	}
+	return weight >= ps.Quorum
 }
 
 func rootHex(r [32]byte) string {
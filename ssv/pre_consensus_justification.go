@@ -43,15 +43,15 @@ func (b *BaseRunner) validatePreConsensusJustifications(data *types.ConsensusDat
 		return errors.New("duty.slot <= highest decided slot")
 	}
 
-	// validate justification quorum
-	if !b.Share.HasQuorum(len(data.PreConsensusJustifications)) {
+	// validate justification quorum, any quorum (by voting power) has at least MinQuorumSize signers
+	if uint64(len(data.PreConsensusJustifications)) < b.Share.MinQuorumSize() {
 		return errors.New("no quorum")
 	}
 
 	signers := make(map[types.OperatorID]bool)
 	roots := make(map[[32]byte]bool)
 	rootCount := 0
-	partialSigContainer := NewPartialSigContainer(b.Share.Quorum)
+	partialSigContainer := NewWeightedPartialSigContainer(b.Share.Quorum, b.Share.OperatorWeights())
 	for i, msg := range data.PreConsensusJustifications {
 		if err := msg.Validate(); err != nil {
 			return err
@@ -107,6 +107,13 @@ func (b *BaseRunner) validatePreConsensusJustifications(data *types.ConsensusDat
 		}
 	}
 
+	// validate the voting power of the (valid) signers
+	for root := range roots {
+		if !partialSigContainer.HasQuorum(root) {
+			return errors.New("no quorum")
+		}
+	}
+
 	return nil
 }
 
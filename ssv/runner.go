@@ -49,6 +49,8 @@ type BaseRunner struct {
 
 	// highestDecidedSlot holds the highest decided duty slot and gets updated after each decided is reached
 	highestDecidedSlot spec.Slot
+	// reconstructor reconstructs the committee's signatures for all the runner's duties, shared by the committee's runners (see SetSignatureReconstructor)
+	reconstructor *types.SignatureReconstructor
 }
 
 func NewBaseRunner(
@@ -74,10 +76,18 @@ func (b *BaseRunner) SetHighestDecidedSlot(slot spec.Slot) {
 	b.highestDecidedSlot = slot
 }
 
+// SetSignatureReconstructor sets the committee's signature reconstructor, caching the Lagrange coefficients of its signer subsets.
+// If not set, every signature is reconstructed with a new reconstructor
+func (b *BaseRunner) SetSignatureReconstructor(reconstructor *types.SignatureReconstructor) {
+	b.reconstructor = reconstructor
+}
+
 // setupForNewDuty is sets the runner for a new duty
 func (b *BaseRunner) baseSetupForNewDuty(duty *types.Duty) {
 	// start new state
-	b.State = NewRunnerState(b.Share.Quorum, duty)
+	b.State = NewWeightedRunnerState(b.Share.Quorum, b.Share.OperatorWeights(), duty)
+	b.State.PreConsensusContainer.reconstructor = b.reconstructor
+	b.State.PostConsensusContainer.reconstructor = b.reconstructor
 }
 
 // baseStartNewDuty is a base func that all runner implementation can call to start a duty
@@ -80,6 +80,11 @@ func (b *BaseRunner) verifyBeaconPartialSignature(signer uint64, signature types
 			if err := pk.Deserialize(n.GetSharePublicKey()); err != nil {
 				return errors.Wrap(err, "could not deserialized pk")
 			}
+			// verified ahead, see Validator.ProcessMessages
+			if b.signatureCache().Contains(signature, [][]byte{n.GetSharePublicKey()}, root) {
+				return nil
+			}
+
 			sig := &bls.Sign{}
 			if err := sig.Deserialize(signature); err != nil {
 				return errors.Wrap(err, "could not deserialized Signature")
@@ -95,6 +100,48 @@ func (b *BaseRunner) verifyBeaconPartialSignature(signer uint64, signature types
 	return errors.New("unknown signer")
 }
 
+// verifyBeaconPartialSignatures verifies the partial signatures at once (see types.SignatureBatch), returns the invalid ones
+// (including those of an unknown signer or that can't be deserialized)
+func (b *BaseRunner) verifyBeaconPartialSignatures(msgs []*types.PartialSignatureMessage) []*types.PartialSignatureMessage {
+	invalid := make([]*types.PartialSignatureMessage, 0)
+	batch := types.NewSignatureBatch().UseCache(b.signatureCache())
+	batched := make([]*types.PartialSignatureMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		pk, found := b.sharePubKey(msg.Signer)
+		if !found {
+			invalid = append(invalid, msg)
+			continue
+		}
+		if err := batch.Add(msg.PartialSignature, [][]byte{pk}, msg.SigningRoot); err != nil {
+			invalid = append(invalid, msg)
+			continue
+		}
+		batched = append(batched, msg)
+	}
+	for _, i := range batch.Verify() {
+		invalid = append(invalid, batched[i])
+	}
+	return invalid
+}
+
+// signatureCache returns the cache of verified BLS signatures of the runner's QBFT config, nil if none
+func (b *BaseRunner) signatureCache() *types.BLSSignatureCache {
+	if b.QBFTController == nil {
+		return nil
+	}
+	return b.QBFTController.GetConfig().GetSignatureCache()
+}
+
+// sharePubKey returns the share public key of the committee's operator
+func (b *BaseRunner) sharePubKey(signer types.OperatorID) ([]byte, bool) {
+	for _, n := range b.Share.Committee {
+		if n.GetID() == signer {
+			return n.GetSharePublicKey(), true
+		}
+	}
+	return nil, false
+}
+
 // Stores the container's existing signature or the new one, depending on their validity. If both are invalid, remove the existing one
 func (b *BaseRunner) resolveDuplicateSignature(container *PartialSigContainer, msg *types.PartialSignatureMessage) {
 
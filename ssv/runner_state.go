@@ -23,9 +23,14 @@ type State struct {
 }
 
 func NewRunnerState(quorum uint64, duty *types.Duty) *State {
+	return NewWeightedRunnerState(quorum, nil, duty)
+}
+
+// NewWeightedRunnerState returns a runner state whose partial signature containers reach quorum by the signers' voting power
+func NewWeightedRunnerState(quorum uint64, weights map[types.OperatorID]uint64, duty *types.Duty) *State {
 	return &State{
-		PreConsensusContainer:  NewPartialSigContainer(quorum),
-		PostConsensusContainer: NewPartialSigContainer(quorum),
+		PreConsensusContainer:  NewWeightedPartialSigContainer(quorum, weights),
+		PostConsensusContainer: NewWeightedPartialSigContainer(quorum, weights),
 
 		StartingDuty: duty,
 		Finished:     false,
@@ -27,15 +27,28 @@ func (b *BaseRunner) ValidatePreConsensusMsg(runner Runner, signedMsg *types.Sig
 	return b.verifyExpectedRoot(runner, signedMsg, roots, domain)
 }
 
-// Verify each signature in container removing the invalid ones
+// Verify the container's signatures removing the invalid ones, all signatures are verified at once and bisected to find the invalid ones
 func (b *BaseRunner) FallBackAndVerifyEachSignature(container *PartialSigContainer, root [32]byte) {
 
 	signatures := container.GetSignatures(root)
 
-	for operatorID, signature := range signatures {
-		if err := b.verifyBeaconPartialSignature(operatorID, signature, root); err != nil {
-			container.Remove(operatorID, root)
-		}
+	signers := make([]types.OperatorID, 0, len(signatures))
+	for operatorID := range signatures {
+		signers = append(signers, operatorID)
+	}
+	sort.Slice(signers, func(i, j int) bool { return signers[i] < signers[j] })
+
+	msgs := make([]*types.PartialSignatureMessage, 0, len(signers))
+	for _, operatorID := range signers {
+		msgs = append(msgs, &types.PartialSignatureMessage{
+			PartialSignature: signatures[operatorID],
+			SigningRoot:      root,
+			Signer:           operatorID,
+		})
+	}
+
+	for _, invalid := range b.verifyBeaconPartialSignatures(msgs) {
+		container.Remove(invalid.Signer, root)
 	}
 }
 
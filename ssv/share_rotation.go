@@ -0,0 +1,62 @@
+package ssv
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// RotateShare replaces the validator's share with a refreshed share of the same validator and committee (see dkg.Refresh).
+// The new share must be valid (Share.Validate), its committee's share public keys reconstructing the validator public key.
+// The new share's secret must be added to the signer before rotating, the old one can be removed once rotated.
+// Rotation is refused while a duty is running: its partial signatures would be verified against the other shares' public keys
+// and the old shares' signatures don't combine with the new ones.
+func (v *Validator) RotateShare(share *types.Share) error {
+	if err := validateShareRotation(v.Share, share); err != nil {
+		return errors.Wrap(err, "invalid share rotation")
+	}
+	if err := share.Validate(); err != nil {
+		return errors.Wrap(err, "invalid share rotation")
+	}
+	for role, dutyRunner := range v.DutyRunners {
+		if dutyRunner.HasRunningDuty() {
+			return errors.Errorf("can't rotate share, %s duty running", role.String())
+		}
+	}
+
+	v.Share = share
+	for _, dutyRunner := range v.DutyRunners {
+		baseRunner := dutyRunner.GetBaseRunner()
+		baseRunner.Share = share
+		if baseRunner.QBFTController != nil {
+			baseRunner.QBFTController.Share = share
+		}
+	}
+	return nil
+}
+
+// validateShareRotation returns error if the new share isn't a refresh of the old one: same validator, operator, quorums and committee operators
+func validateShareRotation(old *types.Share, share *types.Share) error {
+	if !bytes.Equal(old.ValidatorPubKey, share.ValidatorPubKey) {
+		return errors.New("validator public key changed")
+	}
+	if old.OperatorID != share.OperatorID {
+		return errors.New("operator id changed")
+	}
+	if old.Quorum != share.Quorum || old.PartialQuorum != share.PartialQuorum {
+		return errors.New("quorum changed")
+	}
+	if len(old.Committee) != len(share.Committee) {
+		return errors.New("committee changed")
+	}
+	for i, operator := range share.Committee {
+		if operator.OperatorID != old.Committee[i].OperatorID || operator.GetWeight() != old.Committee[i].GetWeight() {
+			return errors.New("committee changed")
+		}
+		if operator.OperatorID == share.OperatorID && !bytes.Equal(operator.SharePubKey, share.SharePubKey) {
+			return errors.New("share public key not in committee")
+		}
+	}
+	return nil
+}
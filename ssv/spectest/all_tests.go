@@ -2,8 +2,10 @@ package spectest
 
 import (
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/doppelganger"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/dutyexe"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/partialsigcontainer"
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/processmessages"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/runner"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/runner/consensus"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/runner/duties/newduty"
@@ -11,6 +13,7 @@ import (
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/runner/duties/synccommitteeaggregator"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/runner/postconsensus"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/runner/preconsensus"
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/sharerotation"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/valcheck/valcheckattestations"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/valcheck/valcheckduty"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/valcheck/valcheckproposer"
@@ -163,4 +166,25 @@ var AllTests = []tests.TestF{
 	partialsigcontainer.Duplicate,
 	partialsigcontainer.DuplicateQuorum,
 	partialsigcontainer.Invalid,
+	partialsigcontainer.WeightedQuorum,
+	partialsigcontainer.WeightedNoQuorum,
+
+	doppelganger.WindowPassed,
+	doppelganger.InProgress,
+	doppelganger.ValidatorRegistration,
+	doppelganger.LiveBeforeStart,
+	doppelganger.LiveDuringWindow,
+	doppelganger.OwnDecided,
+	doppelganger.ForgedDecided,
+	doppelganger.OthersDecided,
+
+	sharerotation.BetweenDuties,
+	sharerotation.OldShareSignature,
+	sharerotation.DuringDuty,
+	sharerotation.ValidatorChanged,
+	sharerotation.CommitteeChanged,
+	sharerotation.ShareNotInCommittee,
+	sharerotation.CommitteeSharePubKeyInvalid,
+
+	processmessages.BadSigners,
 }
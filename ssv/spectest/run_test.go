@@ -15,9 +15,12 @@ import (
 	"github.com/ssvlabs/ssv-spec/qbft"
 	"github.com/ssvlabs/ssv-spec/ssv"
 	tests2 "github.com/ssvlabs/ssv-spec/ssv/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/doppelganger"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/partialsigcontainer"
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/processmessages"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/runner/duties/newduty"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/runner/duties/synccommitteeaggregator"
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/sharerotation"
 	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests/valcheck"
 	"github.com/ssvlabs/ssv-spec/types"
 	"github.com/ssvlabs/ssv-spec/types/testingutils"
@@ -134,6 +137,27 @@ func parseAndTest(t *testing.T, name string, test interface{}) {
 			typedTest := &partialsigcontainer.PartialSigContainerTest{}
 			require.NoError(t, json.Unmarshal(byts, &typedTest))
 
+			typedTest.Run(t)
+		case reflect.TypeOf(&doppelganger.DoppelgangerSpecTest{}).String():
+			byts, err := json.Marshal(test)
+			require.NoError(t, err)
+			typedTest := &doppelganger.DoppelgangerSpecTest{}
+			require.NoError(t, json.Unmarshal(byts, &typedTest))
+
+			typedTest.Run(t)
+		case reflect.TypeOf(&sharerotation.ShareRotationSpecTest{}).String():
+			byts, err := json.Marshal(test)
+			require.NoError(t, err)
+			typedTest := &sharerotation.ShareRotationSpecTest{}
+			require.NoError(t, json.Unmarshal(byts, &typedTest))
+
+			typedTest.Run(t)
+		case reflect.TypeOf(&processmessages.ProcessMessagesSpecTest{}).String():
+			byts, err := json.Marshal(test)
+			require.NoError(t, err)
+			typedTest := &processmessages.ProcessMessagesSpecTest{}
+			require.NoError(t, json.Unmarshal(byts, &typedTest))
+
 			typedTest.Run(t)
 		default:
 			panic("unsupported test type " + testType)
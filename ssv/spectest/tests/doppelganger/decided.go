@@ -0,0 +1,70 @@
+package doppelganger
+
+import (
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// decidedMsg returns an attester decided message signed by the given operators for a height the validator has no instance for
+func decidedMsg(ks *testingutils.TestKeySet, ids []types.OperatorID) *types.SignedSSVMessage {
+	sks := make([]*bls.SecretKey, 0)
+	for _, id := range ids {
+		sks = append(sks, ks.Shares[id])
+	}
+	msgID := types.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingValidatorPubKey[:], types.BNRoleAttester)
+	signedMsg := testingutils.TestingCommitMultiSignerMessageWithHeightAndIdentifier(sks, ids, qbft.Height(5), msgID[:])
+	return testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(signedMsg, nil))
+}
+
+// OwnDecided tests a decided message signed by this operator for a height this operator didn't run
+func OwnDecided() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &DoppelgangerSpecTest{
+		Name:             "own decided",
+		StartEpoch:       10,
+		Epochs:           2,
+		CurrentEpoch:     11,
+		Messages:         []*types.SignedSSVMessage{decidedMsg(ks, []types.OperatorID{1, 2, 3})},
+		Duty:             &testingutils.TestingAttesterDuty,
+		ExpectedDetected: true,
+		ExpectedError:    "can't start duty: doppelganger detected",
+	}
+}
+
+// ForgedDecided tests a decided message naming this operator as a signer without its signature, forged by another committee operator
+func ForgedDecided() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	msgID := types.NewMsgID(testingutils.TestingSSVDomainType, testingutils.TestingValidatorPubKey[:], types.BNRoleAttester)
+	signedMsg := testingutils.TestingCommitMultiSignerMessageWithHeightAndIdentifier(
+		[]*bls.SecretKey{ks.Shares[2], ks.Shares[3], ks.Shares[4]},
+		[]types.OperatorID{1, 2, 3},
+		qbft.Height(5),
+		msgID[:],
+	)
+	return &DoppelgangerSpecTest{
+		Name:         "forged decided",
+		StartEpoch:   10,
+		Epochs:       2,
+		CurrentEpoch: 12,
+		Messages: []*types.SignedSSVMessage{
+			testingutils.SignedSSVMessageWithSigner(2, ks.OperatorKeys[2], testingutils.SSVMsgAttester(signedMsg, nil)),
+		},
+		Duty: &testingutils.TestingAttesterDuty,
+	}
+}
+
+// OthersDecided tests a decided message not signed by this operator, expected while the rest of the cluster keeps running
+func OthersDecided() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &DoppelgangerSpecTest{
+		Name:         "others decided",
+		StartEpoch:   10,
+		Epochs:       2,
+		CurrentEpoch: 12,
+		Messages:     []*types.SignedSSVMessage{decidedMsg(ks, []types.OperatorID{2, 3, 4})},
+		Duty:         &testingutils.TestingAttesterDuty,
+	}
+}
@@ -0,0 +1,18 @@
+package doppelganger
+
+import (
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// InProgress tests starting a duty while the detection window didn't pass yet
+func InProgress() tests.SpecTest {
+	return &DoppelgangerSpecTest{
+		Name:          "in progress",
+		StartEpoch:    10,
+		Epochs:        2,
+		CurrentEpoch:  11,
+		Duty:          &testingutils.TestingAttesterDuty,
+		ExpectedError: "can't start duty: doppelganger protection in progress",
+	}
+}
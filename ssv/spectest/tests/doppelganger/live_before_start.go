@@ -0,0 +1,21 @@
+package doppelganger
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// LiveBeforeStart tests a validator that was live in the epochs before protection started
+func LiveBeforeStart() tests.SpecTest {
+	return &DoppelgangerSpecTest{
+		Name:             "live before start",
+		LiveEpochs:       []phase0.Epoch{9},
+		StartEpoch:       10,
+		Epochs:           2,
+		CurrentEpoch:     12,
+		Duty:             &testingutils.TestingAttesterDuty,
+		ExpectedDetected: true,
+		ExpectedError:    "doppelganger detected, validator live in epoch 9",
+	}
+}
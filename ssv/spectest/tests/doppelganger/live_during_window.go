@@ -0,0 +1,19 @@
+package doppelganger
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// LiveDuringWindow tests a validator that was live during the detection window, kept live by the rest of the committee (not a doppelganger)
+func LiveDuringWindow() tests.SpecTest {
+	return &DoppelgangerSpecTest{
+		Name:         "live during window",
+		LiveEpochs:   []phase0.Epoch{10, 11},
+		StartEpoch:   10,
+		Epochs:       2,
+		CurrentEpoch: 12,
+		Duty:         &testingutils.TestingAttesterDuty,
+	}
+}
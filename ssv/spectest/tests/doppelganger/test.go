@@ -0,0 +1,63 @@
+package doppelganger
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/ssv-spec/ssv"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+type DoppelgangerSpecTest struct {
+	Name string
+	// LiveEpochs are the epochs in which the beacon node reports the validator as live
+	LiveEpochs   []phase0.Epoch
+	StartEpoch   phase0.Epoch
+	Epochs       uint64
+	CurrentEpoch phase0.Epoch
+	// Messages are processed by the validator after protection is updated to CurrentEpoch
+	Messages         []*types.SignedSSVMessage
+	Duty             *types.Duty
+	ExpectedDetected bool
+	ExpectedError    string
+}
+
+func (test *DoppelgangerSpecTest) TestName() string {
+	return "doppelganger " + test.Name
+}
+
+func (test *DoppelgangerSpecTest) Run(t *testing.T) {
+	ks := testingutils.Testing4SharesSet()
+	v := testingutils.BaseValidator(ks)
+	v.DutyRunners[types.BNRoleValidatorRegistration] = testingutils.ValidatorRegistrationRunner(ks)
+	v.Beacon.(*testingutils.TestingBeaconNode).SetLiveEpochs(test.LiveEpochs)
+
+	err := test.runValidator(v)
+	if len(test.ExpectedError) > 0 {
+		require.EqualError(t, err, test.ExpectedError)
+	} else {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, test.ExpectedDetected, v.Doppelganger.Detected)
+}
+
+func (test *DoppelgangerSpecTest) runValidator(v *ssv.Validator) error {
+	if err := v.StartDoppelgangerProtection(test.StartEpoch, test.Epochs); err != nil {
+		return err
+	}
+	if err := v.UpdateDoppelgangerProtection(test.CurrentEpoch); err != nil {
+		return err
+	}
+	for _, msg := range test.Messages {
+		// processing errors are not tested here, only their effect on the protection state
+		_ = v.ProcessMessage(msg)
+	}
+	return v.StartDuty(test.Duty)
+}
+
+func (test *DoppelgangerSpecTest) GetPostState() (interface{}, error) {
+	return nil, nil
+}
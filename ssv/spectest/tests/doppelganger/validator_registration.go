@@ -0,0 +1,17 @@
+package doppelganger
+
+import (
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// ValidatorRegistration tests a validator registration duty (not protected) starts while the detection window didn't pass yet
+func ValidatorRegistration() tests.SpecTest {
+	return &DoppelgangerSpecTest{
+		Name:         "validator registration allowed",
+		StartEpoch:   10,
+		Epochs:       2,
+		CurrentEpoch: 10,
+		Duty:         &testingutils.TestingValidatorRegistrationDuty,
+	}
+}
@@ -0,0 +1,17 @@
+package doppelganger
+
+import (
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// WindowPassed tests starting a duty after the detection window passed without the validator being live
+func WindowPassed() tests.SpecTest {
+	return &DoppelgangerSpecTest{
+		Name:         "window passed",
+		StartEpoch:   10,
+		Epochs:       2,
+		CurrentEpoch: 12,
+		Duty:         &testingutils.TestingAttesterDuty,
+	}
+}
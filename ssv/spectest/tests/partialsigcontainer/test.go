@@ -11,6 +11,7 @@ import (
 type PartialSigContainerTest struct {
 	Name            string
 	Quorum          uint64
+	Weights         map[types.OperatorID]uint64 `json:",omitempty"`
 	ValidatorPubKey []byte
 	SignatureMsgs   []*types.PartialSignatureMessage
 	ExpectedError   string
@@ -23,7 +24,7 @@ func (test *PartialSigContainerTest) TestName() string {
 }
 
 func (test *PartialSigContainerTest) Run(t *testing.T) {
-	ps := ssv.NewPartialSigContainer(test.Quorum)
+	ps := ssv.NewWeightedPartialSigContainer(test.Quorum, test.Weights)
 
 	roots := make(map[[32]byte]bool)
 	// Add signature messages
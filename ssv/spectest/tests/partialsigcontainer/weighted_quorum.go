@@ -0,0 +1,76 @@
+package partialsigcontainer
+
+import (
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// WeightedQuorum tests a quorum by voting power including the heavy signer (weights [2,1,1,1,1,1,1], quorum 6 of 8).
+// Any quorum has at least 5 signers (the key set threshold), so the signature can be reconstructed
+func WeightedQuorum() tests.SpecTest {
+
+	// Create a test key set
+	ks := testingutils.Testing7SharesSet()
+	share := testingutils.TestingWeightedShare(ks, map[types.OperatorID]uint64{1: 2})
+
+	// Create PartialSignatureMessage for testing
+	msgs := make([]*types.PartialSignatureMessage, 0)
+	sigs := make(map[types.OperatorID][]byte)
+	for _, signer := range []types.OperatorID{1, 2, 3, 4, 5} {
+		msg := testingutils.PostConsensusAttestationMsg(ks.Shares[signer], signer, qbft.FirstHeight).Message.Messages[0]
+		msgs = append(msgs, msg)
+		sigs[signer] = msg.PartialSignature
+	}
+
+	// Verify the reconstructed signature
+	expectedSig, err := types.ReconstructSignatures(sigs)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return &PartialSigContainerTest{
+		Name:            "weighted quorum",
+		Quorum:          share.Quorum,
+		Weights:         share.OperatorWeights(),
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		SignatureMsgs:   msgs,
+		ExpectedResult:  expectedSig.Serialize(),
+		ExpectedQuorum:  true,
+	}
+}
+
+// WeightedNoQuorum tests 5 light signers don't have quorum by voting power (weights [2,1,1,1,1,1,1], 5 < quorum 6),
+// although they reach the key set threshold
+func WeightedNoQuorum() tests.SpecTest {
+
+	// Create a test key set
+	ks := testingutils.Testing7SharesSet()
+	share := testingutils.TestingWeightedShare(ks, map[types.OperatorID]uint64{1: 2})
+
+	// Create PartialSignatureMessage for testing
+	msgs := make([]*types.PartialSignatureMessage, 0)
+	sigs := make(map[types.OperatorID][]byte)
+	for _, signer := range []types.OperatorID{2, 3, 4, 5, 6} {
+		msg := testingutils.PostConsensusAttestationMsg(ks.Shares[signer], signer, qbft.FirstHeight).Message.Messages[0]
+		msgs = append(msgs, msg)
+		sigs[signer] = msg.PartialSignature
+	}
+
+	// Verify the reconstructed signature
+	expectedSig, err := types.ReconstructSignatures(sigs)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return &PartialSigContainerTest{
+		Name:            "weighted no quorum",
+		Quorum:          share.Quorum,
+		Weights:         share.OperatorWeights(),
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		SignatureMsgs:   msgs,
+		ExpectedResult:  expectedSig.Serialize(),
+		ExpectedQuorum:  false,
+	}
+}
@@ -0,0 +1,47 @@
+package processmessages
+
+import (
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// BadSigners tests pending messages with a decided message whose aggregated signature misses signer 3's and a post consensus
+// message of signer 4 signed by another share. Verified at once the invalid signatures are found by bisection but not dropped,
+// the results are the same as processing each message: the decided message is rejected, the partial signature is removed
+// once the reconstructed signature is invalid and the duty completes with the other messages
+func BadSigners() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	msgs := []*types.SignedSSVMessage{
+		testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(
+			testingutils.TestingCommitMultiSignerMessageWithHeightIdentifierAndFullData(
+				[]*bls.SecretKey{ks.Shares[1], ks.Shares[2], ks.Shares[4]},
+				[]types.OperatorID{1, 2, 3},
+				qbft.Height(testingutils.TestingDutySlot),
+				testingutils.AttesterMsgID,
+				testingutils.TestAttesterConsensusDataByts,
+			), nil)),
+	}
+	msgs = append(msgs, testingutils.SignedSSVMessageListF(ks, testingutils.SSVDecidingMsgsV(testingutils.TestAttesterConsensusData, ks, types.BNRoleAttester))...)
+	msgs = append(msgs,
+		testingutils.SignedSSVMessageWithSigner(4, ks.OperatorKeys[4], testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[3], 4, qbft.FirstHeight))),
+		testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, qbft.FirstHeight))),
+		testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[2], 2, qbft.FirstHeight))),
+		testingutils.SignedSSVMessageF(ks, testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[3], 3, qbft.FirstHeight))),
+	)
+
+	return &ProcessMessagesSpecTest{
+		Name:     "bad signers",
+		Duty:     &testingutils.TestingAttesterDuty,
+		Messages: msgs,
+		ExpectedErrors: map[int]string{
+			0:             "failed processing consensus message: invalid decided msg: invalid decided msg: msg signature invalid: failed to verify signature",
+			len(msgs) - 2: "got post-consensus quorum but it has invalid signatures: could not reconstruct beacon sig: failed to verify reconstruct signature: could not reconstruct a valid signature",
+		},
+		BeaconBroadcastedRoots: []string{
+			testingutils.GetSSZRootNoError(testingutils.TestingSignedAttestation(ks)),
+		},
+	}
+}
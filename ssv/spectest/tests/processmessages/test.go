@@ -0,0 +1,72 @@
+package processmessages
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/ssv"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// ProcessMessagesSpecTest processes pending messages at once with Validator.ProcessMessages after starting an attester duty,
+// the results must be the same as processing each message with Validator.ProcessMessage
+type ProcessMessagesSpecTest struct {
+	Name     string
+	Duty     *types.Duty
+	Messages []*types.SignedSSVMessage
+	// ExpectedErrors are the errors of the messages by index, the other messages are processed without error
+	ExpectedErrors         map[int]string
+	BeaconBroadcastedRoots []string
+}
+
+func (test *ProcessMessagesSpecTest) TestName() string {
+	return "process messages " + test.Name
+}
+
+func (test *ProcessMessagesSpecTest) Run(t *testing.T) {
+	v, runner := test.validator()
+	require.NoError(t, v.StartDuty(test.Duty))
+	errs := v.ProcessMessages(test.Messages)
+	require.Len(t, errs, len(test.Messages))
+	for i, err := range errs {
+		if expected, found := test.ExpectedErrors[i]; found {
+			require.EqualError(t, err, expected, fmt.Sprintf("message %d", i))
+		} else {
+			require.NoError(t, err, fmt.Sprintf("message %d", i))
+		}
+	}
+	broadcastedRoots := runner.GetBeaconNode().(*testingutils.TestingBeaconNode).BroadcastedRoots
+	require.Len(t, broadcastedRoots, len(test.BeaconBroadcastedRoots))
+	for i, root := range broadcastedRoots {
+		require.EqualValues(t, test.BeaconBroadcastedRoots[i], hex.EncodeToString(root[:]))
+	}
+
+	// same results processing each message
+	v, runner = test.validator()
+	require.NoError(t, v.StartDuty(test.Duty))
+	for i, msg := range test.Messages {
+		err := v.ProcessMessage(msg)
+		if errs[i] != nil {
+			require.EqualError(t, err, errs[i].Error(), fmt.Sprintf("message %d", i))
+		} else {
+			require.NoError(t, err, fmt.Sprintf("message %d", i))
+		}
+	}
+	require.EqualValues(t, broadcastedRoots, runner.GetBeaconNode().(*testingutils.TestingBeaconNode).BroadcastedRoots)
+}
+
+func (test *ProcessMessagesSpecTest) validator() (*ssv.Validator, ssv.Runner) {
+	ks := testingutils.Testing4SharesSet()
+	v := testingutils.BaseValidator(ks)
+	runner := testingutils.AttesterRunner(ks)
+	v.DutyRunners[types.BNRoleAttester] = runner
+	v.Network = runner.GetNetwork()
+	return v, runner
+}
+
+func (test *ProcessMessagesSpecTest) GetPostState() (interface{}, error) {
+	return nil, nil
+}
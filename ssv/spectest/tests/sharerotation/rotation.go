@@ -0,0 +1,96 @@
+package sharerotation
+
+import (
+	"github.com/ssvlabs/ssv-spec/ssv/spectest/tests"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// BetweenDuties tests rotating to a refreshed share between duties, the next duty's new shares' partial signatures reconstruct the validator's signature
+func BetweenDuties() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	refreshed := ks.Refreshed()
+	return &ShareRotationSpecTest{
+		Name:     "between duties",
+		NewShare: testingutils.TestingShare(refreshed),
+		Duty:     &testingutils.TestingValidatorRegistrationDuty,
+		Messages: []*types.SignedSSVMessage{
+			testingutils.SignedSSVMessageWithSigner(1, ks.OperatorKeys[1], testingutils.SSVMsgValidatorRegistration(nil, testingutils.PreConsensusValidatorRegistrationMsg(refreshed.Shares[1], 1))),
+			testingutils.SignedSSVMessageWithSigner(2, ks.OperatorKeys[2], testingutils.SSVMsgValidatorRegistration(nil, testingutils.PreConsensusValidatorRegistrationMsg(refreshed.Shares[2], 2))),
+			testingutils.SignedSSVMessageWithSigner(3, ks.OperatorKeys[3], testingutils.SSVMsgValidatorRegistration(nil, testingutils.PreConsensusValidatorRegistrationMsg(refreshed.Shares[3], 3))),
+		},
+	}
+}
+
+// OldShareSignature tests an old share's partial signature after rotating, it doesn't combine with the new shares' partial signatures
+func OldShareSignature() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	refreshed := ks.Refreshed()
+	return &ShareRotationSpecTest{
+		Name:     "old share signature",
+		NewShare: testingutils.TestingShare(refreshed),
+		Duty:     &testingutils.TestingValidatorRegistrationDuty,
+		Messages: []*types.SignedSSVMessage{
+			testingutils.SignedSSVMessageWithSigner(1, ks.OperatorKeys[1], testingutils.SSVMsgValidatorRegistration(nil, testingutils.PreConsensusValidatorRegistrationMsg(refreshed.Shares[1], 1))),
+			testingutils.SignedSSVMessageWithSigner(2, ks.OperatorKeys[2], testingutils.SSVMsgValidatorRegistration(nil, testingutils.PreConsensusValidatorRegistrationMsg(ks.Shares[2], 2))),
+			testingutils.SignedSSVMessageWithSigner(3, ks.OperatorKeys[3], testingutils.SSVMsgValidatorRegistration(nil, testingutils.PreConsensusValidatorRegistrationMsg(refreshed.Shares[3], 3))),
+		},
+		ExpectedError: "got pre-consensus quorum but it has invalid signatures: could not reconstruct beacon sig: failed to verify reconstruct signature: could not reconstruct a valid signature",
+	}
+}
+
+// DuringDuty tests rotating while a duty is running
+func DuringDuty() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &ShareRotationSpecTest{
+		Name:          "during duty",
+		RunningDuty:   &testingutils.TestingAttesterDuty,
+		NewShare:      testingutils.TestingShare(ks.Refreshed()),
+		ExpectedError: "can't rotate share, ATTESTER duty running",
+	}
+}
+
+// ValidatorChanged tests rotating to another validator's share
+func ValidatorChanged() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	share := testingutils.TestingShare(ks.Refreshed())
+	share.ValidatorPubKey = ks.Shares[1].GetPublicKey().Serialize()
+	return &ShareRotationSpecTest{
+		Name:          "validator changed",
+		NewShare:      share,
+		ExpectedError: "invalid share rotation: validator public key changed",
+	}
+}
+
+// CommitteeChanged tests rotating to a share of another committee, which requires a reshare
+func CommitteeChanged() tests.SpecTest {
+	return &ShareRotationSpecTest{
+		Name:          "committee changed",
+		NewShare:      testingutils.TestingShare(testingutils.Testing7SharesSet().Refreshed()),
+		ExpectedError: "invalid share rotation: quorum changed",
+	}
+}
+
+// ShareNotInCommittee tests rotating to a share whose public key isn't the committee's
+func ShareNotInCommittee() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	share := testingutils.TestingShare(ks.Refreshed())
+	share.SharePubKey = ks.Shares[1].GetPublicKey().Serialize()
+	return &ShareRotationSpecTest{
+		Name:          "share not in committee",
+		NewShare:      share,
+		ExpectedError: "invalid share rotation: share public key not in committee",
+	}
+}
+
+// CommitteeSharePubKeyInvalid tests rotating to a share whose committee has another operator's share public key not on the validator's polynomial
+func CommitteeSharePubKeyInvalid() tests.SpecTest {
+	ks := testingutils.Testing4SharesSet()
+	share := testingutils.TestingShare(ks.Refreshed())
+	share.Committee[3].SharePubKey = ks.Shares[4].GetPublicKey().Serialize()
+	return &ShareRotationSpecTest{
+		Name:          "committee share public key invalid",
+		NewShare:      share,
+		ExpectedError: "invalid share rotation: operator 4 share public key doesn't interpolate to validator public key",
+	}
+}
@@ -0,0 +1,75 @@
+package sharerotation
+
+import (
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/ssv"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+type ShareRotationSpecTest struct {
+	Name string
+	// RunningDuty is started before rotating, nil if none
+	RunningDuty *types.Duty
+	NewShare    *types.Share
+	// Duty is started after rotating, nil if none
+	Duty *types.Duty
+	// Messages are processed by the validator after Duty started
+	Messages      []*types.SignedSSVMessage
+	ExpectedError string
+}
+
+func (test *ShareRotationSpecTest) TestName() string {
+	return "share rotation " + test.Name
+}
+
+func (test *ShareRotationSpecTest) Run(t *testing.T) {
+	ks := testingutils.Testing4SharesSet()
+	v := testingutils.BaseValidator(ks)
+	v.DutyRunners[types.BNRoleValidatorRegistration] = testingutils.ValidatorRegistrationRunner(ks)
+
+	err := test.runValidator(v)
+	if len(test.ExpectedError) > 0 {
+		require.EqualError(t, err, test.ExpectedError)
+		return
+	}
+	require.NoError(t, err)
+
+	// every runner signs and verifies with the new share
+	require.EqualValues(t, test.NewShare, v.Share)
+	for _, dutyRunner := range v.DutyRunners {
+		require.EqualValues(t, test.NewShare, dutyRunner.GetBaseRunner().Share)
+		if dutyRunner.GetBaseRunner().QBFTController != nil {
+			require.EqualValues(t, test.NewShare, dutyRunner.GetBaseRunner().QBFTController.Share)
+		}
+	}
+}
+
+func (test *ShareRotationSpecTest) runValidator(v *ssv.Validator) error {
+	if test.RunningDuty != nil {
+		if err := v.StartDuty(test.RunningDuty); err != nil {
+			return err
+		}
+	}
+	if err := v.RotateShare(test.NewShare); err != nil {
+		return err
+	}
+	if test.Duty == nil {
+		return nil
+	}
+	if err := v.StartDuty(test.Duty); err != nil {
+		return err
+	}
+	for _, msg := range test.Messages {
+		if err := v.ProcessMessage(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (test *ShareRotationSpecTest) GetPostState() (interface{}, error) {
+	return nil, nil
+}
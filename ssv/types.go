@@ -84,6 +84,12 @@ type VoluntaryExitCalls interface {
 	SubmitVoluntaryExit(voluntaryExit *phase0.SignedVoluntaryExit) error
 }
 
+// DoppelgangerCalls interface has all doppelganger protection specific calls
+type DoppelgangerCalls interface {
+	// GetValidatorLiveness returns true if the validator was seen live (attesting, proposing, etc.) on chain in the given epoch
+	GetValidatorLiveness(pubKey phase0.BLSPubKey, epoch phase0.Epoch) (bool, error)
+}
+
 type DomainCalls interface {
 	DomainData(epoch phase0.Epoch, domain phase0.DomainType) (phase0.Domain, error)
 }
@@ -98,5 +104,6 @@ type BeaconNode interface {
 	SyncCommitteeContributionCalls
 	ValidatorRegistrationCalls
 	VoluntaryExitCalls
+	DoppelgangerCalls
 	DomainCalls
 }
@@ -17,6 +17,8 @@ type Validator struct {
 	Signer            types.KeyManager
 	OperatorSigner    types.OperatorSigner
 	SignatureVerifier types.SignatureVerifier
+	// Doppelganger holds the doppelganger protection phase state, nil if protection is disabled
+	Doppelganger *DoppelgangerProtection
 }
 
 func NewValidator(
@@ -27,7 +29,17 @@ func NewValidator(
 	operatorSigner types.OperatorSigner,
 	runners map[types.BeaconRole]Runner,
 	signatureVerifier types.SignatureVerifier,
-) *Validator {
+) (*Validator, error) {
+	if err := share.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid share")
+	}
+
+	// the committee's signer subsets repeat across the runners' duties
+	reconstructor := types.NewSignatureReconstructor()
+	for _, runner := range runners {
+		runner.GetBaseRunner().SetSignatureReconstructor(reconstructor)
+	}
+
 	return &Validator{
 		DutyRunners:       runners,
 		Network:           network,
@@ -36,7 +48,7 @@ func NewValidator(
 		Signer:            signer,
 		OperatorSigner:    operatorSigner,
 		SignatureVerifier: signatureVerifier,
-	}
+	}, nil
 }
 
 // StartDuty starts a duty for the validator
@@ -45,6 +57,9 @@ func (v *Validator) StartDuty(duty *types.Duty) error {
 	if dutyRunner == nil {
 		return errors.Errorf("duty type %s not supported", duty.Type.String())
 	}
+	if err := v.validateDutyDoppelganger(duty); err != nil {
+		return errors.Wrap(err, "can't start duty")
+	}
 	return dutyRunner.StartNewDuty(duty)
 }
 
@@ -61,21 +76,69 @@ func (v *Validator) ProcessMessage(signedSSVMessage *types.SignedSSVMessage) err
 		return errors.Wrap(err, "SignedSSVMessage has an invalid signature")
 	}
 
+	msg, err := v.decodeMessage(signedSSVMessage)
+	if err != nil {
+		return err
+	}
+	return v.processDecodedMessage(msg)
+}
+
+// ProcessMessages processes pending Network Messages of all types, returns the error of each message as ProcessMessage would.
+// The SignedSSVMessages' signatures are verified at once if the SignatureVerifier is a types.BatchSignatureVerifier.
+// The BLS signatures processing may verify are then verified at once per runner and the valid ones cached (see
+// verifyPendingSignatures), the messages are processed in order
+func (v *Validator) ProcessMessages(signedSSVMessages []*types.SignedSSVMessage) []error {
+	ret := make([]error, len(signedSSVMessages))
+	toVerify := make([]int, 0, len(signedSSVMessages))
+	for i, signedSSVMessage := range signedSSVMessages {
+		if err := signedSSVMessage.Validate(); err != nil {
+			ret[i] = errors.Wrap(err, "invalid SignedSSVMessage")
+			continue
+		}
+		toVerify = append(toVerify, i)
+	}
+	v.verifySignedSSVMessages(signedSSVMessages, toVerify, ret)
+
+	decoded := make([]*decodedMessage, len(signedSSVMessages))
+	for i, signedSSVMessage := range signedSSVMessages {
+		if ret[i] == nil {
+			decoded[i], ret[i] = v.decodeMessage(signedSSVMessage)
+		}
+	}
+	v.verifyPendingSignatures(decoded)
+
+	for i := range signedSSVMessages {
+		if ret[i] == nil {
+			ret[i] = v.processDecodedMessage(decoded[i])
+		}
+	}
+	return ret
+}
+
+// decodedMessage is a Network Message decoded for its duty runner, either a consensus or a partial signature message
+type decodedMessage struct {
+	runner        Runner
+	consensusMsg  *qbft.SignedMessage
+	partialSigMsg *types.SignedPartialSignatureMessage
+}
+
+// decodeMessage decodes a SignedSSVMessage, whose signature is valid, for its duty runner
+func (v *Validator) decodeMessage(signedSSVMessage *types.SignedSSVMessage) (*decodedMessage, error) {
 	// Decode the nested SSVMessage
 	msg := &types.SSVMessage{}
 	if err := msg.Decode(signedSSVMessage.Data); err != nil {
-		return errors.Wrap(err, "could not decode data into an SSVMessage")
+		return nil, errors.Wrap(err, "could not decode data into an SSVMessage")
 	}
 
 	// Get runner
 	dutyRunner := v.DutyRunners.DutyRunnerForMsgID(msg.GetID())
 	if dutyRunner == nil {
-		return errors.Errorf("could not get duty runner for msg ID")
+		return nil, errors.Errorf("could not get duty runner for msg ID")
 	}
 
 	// Validate message for runner
 	if err := v.validateMessage(dutyRunner, msg); err != nil {
-		return errors.Wrap(err, "Message invalid")
+		return nil, errors.Wrap(err, "Message invalid")
 	}
 
 	switch msg.GetType() {
@@ -83,35 +146,112 @@ func (v *Validator) ProcessMessage(signedSSVMessage *types.SignedSSVMessage) err
 		// Decode
 		signedMsg := &qbft.SignedMessage{}
 		if err := signedMsg.Decode(msg.GetData()); err != nil {
-			return errors.Wrap(err, "could not get consensus Message from network Message")
+			return nil, errors.Wrap(err, "could not get consensus Message from network Message")
 		}
 
 		// Check signer consistency
 		if !signedMsg.CommonSigners([]types.OperatorID{signedSSVMessage.OperatorID}) {
-			return errors.New("SignedSSVMessage's signer not consistent with SignedMessage's signers")
+			return nil, errors.New("SignedSSVMessage's signer not consistent with SignedMessage's signers")
 		}
-
-		// Process
-		return dutyRunner.ProcessConsensus(signedMsg)
+		return &decodedMessage{runner: dutyRunner, consensusMsg: signedMsg}, nil
 	case types.SSVPartialSignatureMsgType:
 		// Decode
 		signedMsg := &types.SignedPartialSignatureMessage{}
 		if err := signedMsg.Decode(msg.GetData()); err != nil {
-			return errors.Wrap(err, "could not get post consensus Message from network Message")
+			return nil, errors.Wrap(err, "could not get post consensus Message from network Message")
 		}
 
 		// Check signer consistency
 		if signedMsg.Signer != signedSSVMessage.OperatorID {
-			return errors.New("SignedSSVMessage's signer not consistent with SignedPartialSignatureMessage's signer")
+			return nil, errors.New("SignedSSVMessage's signer not consistent with SignedPartialSignatureMessage's signer")
 		}
+		return &decodedMessage{runner: dutyRunner, partialSigMsg: signedMsg}, nil
+	default:
+		return nil, errors.New("unknown msg")
+	}
+}
+
+// processDecodedMessage processes a decoded message by its duty runner
+func (v *Validator) processDecodedMessage(msg *decodedMessage) error {
+	if msg.consensusMsg != nil {
+		// Watch for decided messages signed by this operator elsewhere
+		v.watchDecidedForDoppelganger(msg.runner, msg.consensusMsg)
 
 		// Process
-		if signedMsg.Message.Type == types.PostConsensusPartialSig {
-			return dutyRunner.ProcessPostConsensus(signedMsg)
+		return msg.runner.ProcessConsensus(msg.consensusMsg)
+	}
+
+	// Process
+	if msg.partialSigMsg.Message.Type == types.PostConsensusPartialSig {
+		return msg.runner.ProcessPostConsensus(msg.partialSigMsg)
+	}
+	return msg.runner.ProcessPreConsensus(msg.partialSigMsg)
+}
+
+// verifySignedSSVMessages verifies the signatures of the SignedSSVMessages at the indexes, at once if the SignatureVerifier is a
+// types.BatchSignatureVerifier, setting the errors of the invalid ones
+func (v *Validator) verifySignedSSVMessages(signedSSVMessages []*types.SignedSSVMessage, indexes []int, errs []error) {
+	batchVerifier, ok := v.SignatureVerifier.(types.BatchSignatureVerifier)
+	if !ok {
+		for _, i := range indexes {
+			if err := v.SignatureVerifier.Verify(signedSSVMessages[i], v.Share.Committee); err != nil {
+				errs[i] = errors.Wrap(err, "SignedSSVMessage has an invalid signature")
+			}
 		}
-		return dutyRunner.ProcessPreConsensus(signedMsg)
-	default:
-		return errors.New("unknown msg")
+		return
+	}
+
+	msgs := make([]*types.SignedSSVMessage, 0, len(indexes))
+	committees := make([][]*types.Operator, 0, len(indexes))
+	for _, i := range indexes {
+		msgs = append(msgs, signedSSVMessages[i])
+		committees = append(committees, v.Share.Committee)
+	}
+	for j, err := range batchVerifier.VerifyMany(msgs, committees) {
+		if err != nil {
+			errs[indexes[j]] = errors.Wrap(err, "SignedSSVMessage has an invalid signature")
+		}
+	}
+}
+
+// verifyPendingSignatures verifies at once, per runner, the BLS signatures processing the messages may verify: the partial
+// signatures and the signatures of decided messages and QBFT justifications (see qbft.SignaturesVerifiedByProcessing).
+// Valid signatures are cached in the runner's signature cache (see types.BLSSignatureCache) so processing doesn't verify them
+// again, nothing is dropped: a message with an invalid signature is processed as ProcessMessage would
+func (v *Validator) verifyPendingSignatures(msgs []*decodedMessage) {
+	batches := make(map[Runner]*types.SignatureBatch)
+	for _, msg := range msgs {
+		if msg == nil {
+			continue
+		}
+		baseRunner := msg.runner.GetBaseRunner()
+		cache := baseRunner.signatureCache()
+		if cache == nil {
+			continue
+		}
+		batch, found := batches[msg.runner]
+		if !found {
+			batch = types.NewSignatureBatch().UseCache(cache)
+			batches[msg.runner] = batch
+		}
+
+		// signatures that can't be added are left to processing
+		if msg.partialSigMsg != nil {
+			for _, partialSig := range msg.partialSigMsg.Message.Messages {
+				if pk, found := baseRunner.sharePubKey(partialSig.Signer); found {
+					_ = batch.Add(partialSig.PartialSignature, [][]byte{pk}, partialSig.SigningRoot)
+				}
+			}
+			continue
+		}
+		domain := baseRunner.QBFTController.GetConfig().GetSignatureDomainType()
+		for _, signedMsg := range qbft.SignaturesVerifiedByProcessing(baseRunner.Share, msg.consensusMsg) {
+			_ = batch.AddByOperators(signedMsg, domain, types.QBFTSignatureType, baseRunner.Share.Committee)
+		}
+	}
+
+	for _, batch := range batches {
+		batch.Verify()
 	}
 }
 
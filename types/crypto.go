@@ -3,7 +3,6 @@ package types
 import (
 	"bytes"
 	"crypto/sha256"
-	"fmt"
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/ethereum/go-ethereum/common"
@@ -156,30 +155,7 @@ func ComputeSignatureDomain(domain DomainType, sigType SignatureType) SignatureD
 // ReconstructSignatures receives a map of user indexes and serialized bls.Sign.
 // It then reconstructs the original threshold signature using lagrange interpolation
 func ReconstructSignatures(signatures map[OperatorID][]byte) (*bls.Sign, error) {
-	reconstructedSig := bls.Sign{}
-
-	idVec := make([]bls.ID, 0)
-	sigVec := make([]bls.Sign, 0)
-
-	for index, signature := range signatures {
-		blsID := bls.ID{}
-		err := blsID.SetDecString(fmt.Sprintf("%d", index))
-		if err != nil {
-			return nil, err
-		}
-
-		idVec = append(idVec, blsID)
-		blsSig := bls.Sign{}
-
-		err = blsSig.Deserialize(signature)
-		if err != nil {
-			return nil, err
-		}
-
-		sigVec = append(sigVec, blsSig)
-	}
-	err := reconstructedSig.Recover(sigVec, idVec)
-	return &reconstructedSig, err
+	return NewSignatureReconstructor().Reconstruct(signatures)
 }
 
 func VerifyReconstructedSignature(sig *bls.Sign, validatorPubKey []byte, root [32]byte) error {
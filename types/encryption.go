@@ -1,15 +1,28 @@
 package types
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/binary"
 	"encoding/pem"
+
 	"github.com/pkg/errors"
 )
 
 var keySize = 2048
 
+// EncryptionEnvelopeV1 is the version of EncryptShare's envelope
+const EncryptionEnvelopeV1 byte = 1
+
+const (
+	gcmNonceSize = 12
+	gcmTagSize   = 16
+)
+
 // GenerateKey using rsa random generate keys
 func GenerateKey() ([]byte, []byte, error) {
 	// generate random private key (secret)
@@ -27,7 +40,8 @@ func GenerateKey() ([]byte, []byte, error) {
 	return skPem, pkPem, nil
 }
 
-// Decrypt with secret key (base64) and bytes, return the encrypted key string
+// Decrypt with secret key (base64) and bytes, return the encrypted key string.
+// Deprecated: PKCS1v15 is vulnerable to padding oracle attacks, use DecryptShare (DecryptLegacyShare to migrate legacy payloads)
 func Decrypt(sk *rsa.PrivateKey, cipherText []byte) ([]byte, error) {
 	decrypted, err := rsa.DecryptPKCS1v15(rand.Reader, sk, cipherText)
 	if err != nil {
@@ -36,7 +50,8 @@ func Decrypt(sk *rsa.PrivateKey, cipherText []byte) ([]byte, error) {
 	return decrypted, nil
 }
 
-// Encrypt with secret key (base64) the bytes, return the encrypted key string
+// Encrypt with secret key (base64) the bytes, return the encrypted key string.
+// Deprecated: PKCS1v15 is vulnerable to padding oracle attacks, use EncryptShare
 func Encrypt(pk *rsa.PublicKey, plainText []byte) ([]byte, error) {
 	encrypted, err := rsa.EncryptPKCS1v15(rand.Reader, pk, plainText)
 	if err != nil {
@@ -45,21 +60,114 @@ func Encrypt(pk *rsa.PublicKey, plainText []byte) ([]byte, error) {
 	return encrypted, nil
 }
 
-// PemToPrivateKey return rsa private key from pem
-func PemToPrivateKey(skPem []byte) (*rsa.PrivateKey, error) {
-	block, _ := pem.Decode(skPem)
-	// nolint
-	enc := x509.IsEncryptedPEMBlock(block)
-	b := block.Bytes
-	if enc {
-		var err error
-		// nolint
-		b, err = x509.DecryptPEMBlock(block, nil)
+// EncryptShare encrypts the share secret to the operator's public key in a versioned envelope (EncryptionEnvelopeV1):
+// version || RSA-OAEP(SHA-256) wrapped AES-256-GCM key || nonce || AES-256-GCM ciphertext.
+// The associated data binds the ciphertext to the operator ID and validator public key, it doesn't decrypt for another operator or validator
+func EncryptShare(pk *rsa.PublicKey, operatorID OperatorID, validatorPK []byte, plainText []byte) ([]byte, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "Failed to generate key")
+	}
+	wrappedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, pk, key, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to wrap key")
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "Failed to generate nonce")
+	}
+
+	ret := append([]byte{EncryptionEnvelopeV1}, wrappedKey...)
+	ret = append(ret, nonce...)
+	return gcm.Seal(ret, nonce, plainText, encryptionAssociatedData(EncryptionEnvelopeV1, operatorID, validatorPK)), nil
+}
+
+// DecryptShare decrypts a share secret encrypted with EncryptShare to the operator ID and validator public key.
+// Only envelopes are accepted, a legacy PKCS1v15 payload (the size of the key's modulus, shorter than any envelope) is rejected:
+// decrypting received PKCS1v15 payloads is a padding oracle. Stored legacy payloads are migrated with DecryptLegacyShare
+func DecryptShare(sk *rsa.PrivateKey, operatorID OperatorID, validatorPK []byte, cipherText []byte) ([]byte, error) {
+	if len(cipherText) == 0 {
+		return nil, errors.New("Failed to decrypt, empty cipher text")
+	}
+	gcmOffset := 1 + sk.Size()
+	if len(cipherText) < gcmOffset+gcmNonceSize+gcmTagSize {
+		return nil, errors.New("Failed to decrypt, invalid envelope length")
+	}
+
+	switch cipherText[0] {
+	case EncryptionEnvelopeV1:
+		key, err := rsa.DecryptOAEP(sha256.New(), nil, sk, cipherText[1:gcmOffset], nil)
 		if err != nil {
-			return nil, errors.Wrap(err, "Failed to decrypt private key")
+			return nil, errors.Wrap(err, "Failed to unwrap key")
 		}
+		gcm, err := newGCM(key)
+		if err != nil {
+			return nil, err
+		}
+		nonce := cipherText[gcmOffset : gcmOffset+gcmNonceSize]
+		plainText, err := gcm.Open(nil, nonce, cipherText[gcmOffset+gcmNonceSize:], encryptionAssociatedData(EncryptionEnvelopeV1, operatorID, validatorPK))
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to decrypt envelope")
+		}
+		return plainText, nil
+	default:
+		return nil, errors.New("Failed to decrypt, unknown envelope version")
+	}
+}
+
+// DecryptLegacyShare decrypts a legacy PKCS1v15 share payload, not bound to an operator ID or validator public key.
+// It's for migration only: an operator decrypts its own stored legacy shares once to re-encrypt them with EncryptShare.
+// It must never decrypt payloads received from the network (e.g. DKG deals), its errors are a padding oracle
+func DecryptLegacyShare(sk *rsa.PrivateKey, cipherText []byte) ([]byte, error) {
+	if len(cipherText) != sk.Size() {
+		return nil, errors.New("Failed to decrypt, invalid legacy payload length")
+	}
+	return Decrypt(sk, cipherText)
+}
+
+// encryptionAssociatedData returns version || operator ID (little endian) || validator public key
+func encryptionAssociatedData(version byte, operatorID OperatorID, validatorPK []byte) []byte {
+	ret := make([]byte, 9, 9+len(validatorPK))
+	ret[0] = version
+	binary.LittleEndian.PutUint64(ret[1:], uint64(operatorID))
+	return append(ret, validatorPK...)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to create cipher")
 	}
-	parsedSk, err := x509.ParsePKCS1PrivateKey(b)
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to create gcm")
+	}
+	return gcm, nil
+}
+
+// decodePem returns the pem's block, encrypted pem blocks (RFC 1423) aren't supported
+func decodePem(byts []byte) (*pem.Block, error) {
+	block, _ := pem.Decode(byts)
+	if block == nil {
+		return nil, errors.New("Failed to decode pem")
+	}
+	if _, encrypted := block.Headers["DEK-Info"]; encrypted {
+		return nil, errors.New("Failed to decode pem, encrypted pem not supported")
+	}
+	return block, nil
+}
+
+// PemToPrivateKey return rsa private key from pem
+func PemToPrivateKey(skPem []byte) (*rsa.PrivateKey, error) {
+	block, err := decodePem(skPem)
+	if err != nil {
+		return nil, err
+	}
+	parsedSk, err := x509.ParsePKCS1PrivateKey(block.Bytes)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to parse private key")
 	}
@@ -68,19 +176,11 @@ func PemToPrivateKey(skPem []byte) (*rsa.PrivateKey, error) {
 
 // PemToPublicKey return rsa public key from pem
 func PemToPublicKey(pkPem []byte) (*rsa.PublicKey, error) {
-	block, _ := pem.Decode(pkPem)
-	// nolint
-	enc := x509.IsEncryptedPEMBlock(block)
-	b := block.Bytes
-	if enc {
-		var err error
-		// nolint
-		b, err = x509.DecryptPEMBlock(block, nil)
-		if err != nil {
-			return nil, errors.Wrap(err, "Failed to decrypt private key")
-		}
+	block, err := decodePem(pkPem)
+	if err != nil {
+		return nil, err
 	}
-	parsedPk, err := x509.ParsePKIXPublicKey(b)
+	parsedPk, err := x509.ParsePKIXPublicKey(block.Bytes)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to parse public key")
 	}
@@ -1,10 +1,10 @@
 package types
 
 //go:generate rm -f ./operator_encoding.go
-//go:generate go run github.com/ferranbt/fastssz/sszgen --path operator.go --exclude-objs OperatorID
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path operator.go --include ./operator_keys.go --exclude-objs OperatorID,OperatorKeyEpoch,OperatorKeyType
 
 //go:generate rm -f ./share_encoding.go
-//go:generate go run github.com/ferranbt/fastssz/sszgen --path share.go --include ./operator.go,./messages.go,./signer.go,./domain_type.go
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path share.go --include ./operator.go,./operator_keys.go,./messages.go,./signer.go,./domain_type.go
 
 // rm -f ./messages_encoding.go
 // go run github.com/ferranbt/fastssz/sszgen --path messages.go --include ./operator.go --exclude-objs ValidatorPK,MessageID,MsgType,SignedSSVMessage
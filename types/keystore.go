@@ -0,0 +1,307 @@
+package types
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	ScryptKDF      = "scrypt"
+	PBKDF2KDF      = "pbkdf2"
+	keystoreCipher = "aes-128-ctr"
+	keystoreHash   = "sha256"
+	keystorePRF    = "hmac-sha256"
+
+	keystoreVersion = 4
+	// keystore KDF params recommended by EIP-2335
+	keystoreDKLen       = 32
+	keystoreScryptN     = 262144
+	keystoreScryptR     = 8
+	keystoreScryptP     = 1
+	keystorePBKDF2Count = 262144
+)
+
+// Keystore is an EIP-2335 encrypted keystore of a BLS secret key (https://eips.ethereum.org/EIPS/eip-2335)
+type Keystore struct {
+	Crypto      KeystoreCrypto `json:"crypto"`
+	Description string         `json:"description,omitempty"`
+	// Pubkey is the hex encoded public key of the secret key, without 0x
+	Pubkey  string `json:"pubkey"`
+	Path    string `json:"path"`
+	UUID    string `json:"uuid"`
+	Version uint64 `json:"version"`
+}
+
+// KeystoreCrypto holds the keystore's modules: the password is derived into a decryption key with the KDF,
+// whose second half checksums the cipher message and first half decrypts it
+type KeystoreCrypto struct {
+	KDF      KeystoreModule `json:"kdf"`
+	Checksum KeystoreModule `json:"checksum"`
+	Cipher   KeystoreModule `json:"cipher"`
+}
+
+// KeystoreModule is a keystore crypto module, Message is hex encoded without 0x
+type KeystoreModule struct {
+	Function string         `json:"function"`
+	Params   KeystoreParams `json:"params"`
+	Message  string         `json:"message"`
+}
+
+// KeystoreParams are the params of the keystore's modules, each sets its own (the checksum has none)
+type KeystoreParams struct {
+	// DKLen, N, R, P and Salt are scrypt's params, DKLen, C, PRF and Salt are pbkdf2's
+	DKLen uint64 `json:"dklen,omitempty"`
+	N     uint64 `json:"n,omitempty"`
+	R     uint64 `json:"r,omitempty"`
+	P     uint64 `json:"p,omitempty"`
+	C     uint64 `json:"c,omitempty"`
+	PRF   string `json:"prf,omitempty"`
+	Salt  string `json:"salt,omitempty"`
+	// IV is the cipher's param
+	IV string `json:"iv,omitempty"`
+}
+
+// NewKeystore returns the share key encrypted with the password, deriving the decryption key with the KDF (ScryptKDF or PBKDF2KDF).
+// The salt, IV and UUID are random
+func NewKeystore(shareKey *bls.SecretKey, password string, kdf string) (*Keystore, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "could not generate salt")
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, errors.Wrap(err, "could not generate iv")
+	}
+	uuid := make([]byte, 16)
+	if _, err := rand.Read(uuid); err != nil {
+		return nil, errors.Wrap(err, "could not generate uuid")
+	}
+	uuid[6] = (uuid[6] & 0x0f) | 0x40 // version 4
+	uuid[8] = (uuid[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	ks := &Keystore{
+		Crypto: KeystoreCrypto{
+			Checksum: KeystoreModule{Function: keystoreHash},
+			Cipher: KeystoreModule{
+				Function: keystoreCipher,
+				Params:   KeystoreParams{IV: hex.EncodeToString(iv)},
+			},
+		},
+		Pubkey:  hex.EncodeToString(shareKey.GetPublicKey().Serialize()),
+		UUID:    fmt.Sprintf("%x-%x-%x-%x-%x", uuid[:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:]),
+		Version: keystoreVersion,
+	}
+	switch kdf {
+	case ScryptKDF:
+		ks.Crypto.KDF = KeystoreModule{
+			Function: ScryptKDF,
+			Params: KeystoreParams{
+				DKLen: keystoreDKLen,
+				N:     keystoreScryptN,
+				R:     keystoreScryptR,
+				P:     keystoreScryptP,
+				Salt:  hex.EncodeToString(salt),
+			},
+		}
+	case PBKDF2KDF:
+		ks.Crypto.KDF = KeystoreModule{
+			Function: PBKDF2KDF,
+			Params: KeystoreParams{
+				DKLen: keystoreDKLen,
+				C:     keystorePBKDF2Count,
+				PRF:   keystorePRF,
+				Salt:  hex.EncodeToString(salt),
+			},
+		}
+	default:
+		return nil, errors.New("unknown kdf function")
+	}
+
+	if err := ks.Encrypt(shareKey.Serialize(), password); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// Encrypt sets the keystore's cipher and checksum messages to the secret encrypted with the password,
+// using the keystore's KDF params and IV
+func (ks *Keystore) Encrypt(secret []byte, password string) error {
+	decryptionKey, err := ks.decryptionKey(password)
+	if err != nil {
+		return err
+	}
+	cipherMessage, err := ks.aes128CTR(decryptionKey, secret)
+	if err != nil {
+		return err
+	}
+	ks.Crypto.Cipher.Message = hex.EncodeToString(cipherMessage)
+	checksum := keystoreChecksum(decryptionKey, cipherMessage)
+	ks.Crypto.Checksum.Message = hex.EncodeToString(checksum[:])
+	return nil
+}
+
+// Decrypt returns the keystore's secret, error if the password doesn't match the checksum
+func (ks *Keystore) Decrypt(password string) ([]byte, error) {
+	if ks.Crypto.Checksum.Function != keystoreHash {
+		return nil, errors.New("unknown checksum function")
+	}
+	decryptionKey, err := ks.decryptionKey(password)
+	if err != nil {
+		return nil, err
+	}
+	cipherMessage, err := hex.DecodeString(ks.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cipher message")
+	}
+	expectedChecksum, err := hex.DecodeString(ks.Crypto.Checksum.Message)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid checksum message")
+	}
+	checksum := keystoreChecksum(decryptionKey, cipherMessage)
+	if !bytes.Equal(checksum[:], expectedChecksum) {
+		return nil, errors.New("invalid checksum, wrong password")
+	}
+	return ks.aes128CTR(decryptionKey, cipherMessage)
+}
+
+// DecryptShareKey returns the keystore's share key, error if it doesn't match the keystore's public key
+func (ks *Keystore) DecryptShareKey(password string) (*bls.SecretKey, error) {
+	InitBLS()
+
+	secret, err := ks.Decrypt(password)
+	if err != nil {
+		return nil, err
+	}
+	shareKey := &bls.SecretKey{}
+	if err := shareKey.Deserialize(secret); err != nil {
+		return nil, errors.Wrap(err, "invalid share key")
+	}
+	if hex.EncodeToString(shareKey.GetPublicKey().Serialize()) != strings.TrimPrefix(ks.Pubkey, "0x") {
+		return nil, errors.New("share key doesn't match keystore public key")
+	}
+	return shareKey, nil
+}
+
+// decryptionKey derives the decryption key from the password with the keystore's KDF
+func (ks *Keystore) decryptionKey(password string) ([]byte, error) {
+	params := ks.Crypto.KDF.Params
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid kdf salt")
+	}
+	if params.DKLen < keystoreDKLen {
+		return nil, errors.New("invalid kdf dklen")
+	}
+
+	switch ks.Crypto.KDF.Function {
+	case ScryptKDF:
+		ret, err := scrypt.Key(NormalizeKeystorePassword(password), salt, int(params.N), int(params.R), int(params.P), int(params.DKLen))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not derive scrypt key")
+		}
+		return ret, nil
+	case PBKDF2KDF:
+		if params.PRF != keystorePRF {
+			return nil, errors.New("unknown pbkdf2 prf")
+		}
+		return pbkdf2.Key(NormalizeKeystorePassword(password), salt, int(params.C), int(params.DKLen), sha256.New), nil
+	default:
+		return nil, errors.New("unknown kdf function")
+	}
+}
+
+// aes128CTR encrypts (or decrypts) the data with the decryption key's first 16 bytes and the keystore's IV
+func (ks *Keystore) aes128CTR(decryptionKey []byte, data []byte) ([]byte, error) {
+	if ks.Crypto.Cipher.Function != keystoreCipher {
+		return nil, errors.New("unknown cipher function")
+	}
+	iv, err := hex.DecodeString(ks.Crypto.Cipher.Params.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.New("invalid cipher iv")
+	}
+	block, err := aes.NewCipher(decryptionKey[:16])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create cipher")
+	}
+	ret := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(ret, data)
+	return ret, nil
+}
+
+// keystoreChecksum returns sha256(decryptionKey[16:32] || cipherMessage)
+func keystoreChecksum(decryptionKey []byte, cipherMessage []byte) [32]byte {
+	return sha256.Sum256(append(append([]byte{}, decryptionKey[16:32]...), cipherMessage...))
+}
+
+// NormalizeKeystorePassword returns the password's UTF-8 bytes after NFKD normalization, stripping the C0, C1 and Delete control codes
+func NormalizeKeystorePassword(password string) []byte {
+	ret := make([]byte, 0, len(password))
+	for _, r := range norm.NFKD.String(password) {
+		if unicode.IsControl(r) {
+			continue
+		}
+		ret = append(ret, string(r)...)
+	}
+	return ret
+}
+
+// Encode returns the encoded struct in bytes or error
+func (ks *Keystore) Encode() ([]byte, error) {
+	return json.Marshal(ks)
+}
+
+// Decode returns error if decoding failed
+func (ks *Keystore) Decode(data []byte) error {
+	return json.Unmarshal(data, ks)
+}
+
+// LoadKeystores adds the share keys of the directory's keystores (.json files) to the key manager, returning their public keys.
+// All the keystores are decrypted with the password before any share key is added
+func LoadKeystores(km KeyManager, dir string, password string) ([][]byte, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list keystores")
+	}
+
+	shareKeys := make([]*bls.SecretKey, 0, len(files))
+	for _, file := range files {
+		byts, err := os.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read keystore")
+		}
+		ks := &Keystore{}
+		if err := ks.Decode(byts); err != nil {
+			return nil, errors.Wrapf(err, "could not decode keystore %s", filepath.Base(file))
+		}
+		shareKey, err := ks.DecryptShareKey(password)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decrypt keystore %s", filepath.Base(file))
+		}
+		shareKeys = append(shareKeys, shareKey)
+	}
+
+	ret := make([][]byte, 0, len(shareKeys))
+	for _, shareKey := range shareKeys {
+		if err := km.AddShare(shareKey); err != nil {
+			return nil, errors.Wrap(err, "could not add share")
+		}
+		ret = append(ret, shareKey.GetPublicKey().Serialize())
+	}
+	return ret, nil
+}
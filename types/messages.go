@@ -20,12 +20,16 @@ const (
 	roleTypeSize     = 4
 	roleTypeStartPos = pubKeyStartPos + pubKeySize
 
-	// SignedSSVMessage offsets
-	signatureSize    = 256
-	signatureOffset  = 0
-	operatorIDSize   = 8
-	operatorIDOffset = signatureOffset + signatureSize
-	messageOffset    = operatorIDOffset + operatorIDSize
+	// SignedSSVMessage offsets, the signature's size prefix is followed by the signature, operator ID and message
+	signatureSizeSize = 2
+	signatureOffset   = signatureSizeSize
+	operatorIDSize    = 8
+	maxSignatureSize  = rsaSignatureSize
+
+	// legacy SignedSSVMessage offsets, before variable size signatures: a fixed size (RSA) signature, operator ID and message
+	legacySignatureSize    = rsaSignatureSize
+	legacyOperatorIDOffset = legacySignatureSize
+	legacyMessageOffset    = legacyOperatorIDOffset + operatorIDSize
 )
 
 type Validate interface {
@@ -138,7 +142,7 @@ func (msg *SSVMessage) Decode(data []byte) error {
 
 // SignedSSVMessage is the main message passed within the SSV network. It encapsulates the SSVMessage structure and a signature
 type SignedSSVMessage struct {
-	Signature  [256]byte // Created by the operator's network key
+	Signature  []byte // Created by the operator's network key, its size depends on the operator's key type
 	OperatorID OperatorID
 	Data       []byte
 }
@@ -149,7 +153,7 @@ func (msg *SignedSSVMessage) GetOperatorID() OperatorID {
 }
 
 // GetSignature returns the signature of the OperatorID over Data
-func (msg *SignedSSVMessage) GetSignature() [256]byte {
+func (msg *SignedSSVMessage) GetSignature() []byte {
 	return msg.Signature
 }
 
@@ -170,7 +174,7 @@ func (msg *SignedSSVMessage) GetSSVMessageFromData() (*SSVMessage, error) {
 
 // Encode returns a msg encoded bytes or error
 func (msg *SignedSSVMessage) Encode() ([]byte, error) {
-	return EncodeSignedSSVMessage(msg.Data, msg.OperatorID, msg.Signature), nil
+	return EncodeSignedSSVMessage(msg.Data, msg.OperatorID, msg.Signature)
 }
 
 // Decode returns error if decoding failed
@@ -185,6 +189,23 @@ func (msg *SignedSSVMessage) Decode(data []byte) error {
 	return nil
 }
 
+// EncodeLegacy returns the msg's legacy encoding (see EncodeLegacySignedSSVMessage) or error
+func (msg *SignedSSVMessage) EncodeLegacy() ([]byte, error) {
+	return EncodeLegacySignedSSVMessage(msg.Data, msg.OperatorID, msg.Signature)
+}
+
+// DecodeLegacy returns error if decoding the legacy encoding failed
+func (msg *SignedSSVMessage) DecodeLegacy(data []byte) error {
+	msgData, operatorID, signature, err := DecodeLegacySignedSSVMessage(data)
+	if err != nil {
+		return errors.Wrap(err, "could not decode legacy data into a SignedSSVMessage")
+	}
+	msg.Data = msgData
+	msg.OperatorID = operatorID
+	msg.Signature = signature
+	return nil
+}
+
 // Validate checks the following rules:
 // - OperatorID should not be 0
 // - Signature length should not be 0
@@ -193,31 +214,75 @@ func (msg *SignedSSVMessage) Validate() error {
 	if msg.OperatorID == 0 {
 		return errors.New("signer ID 0 not allowed")
 	}
+	if len(msg.Signature) == 0 {
+		return errors.New("empty signature")
+	}
 	if len(msg.Data) == 0 {
 		return errors.New("Data has length 0 in SignedSSVMessage")
 	}
 	return nil
 }
 
-// EncodeSignedSSVMessage serializes the message, op id and signature into bytes
-func EncodeSignedSSVMessage(message []byte, operatorID OperatorID, signature [256]byte) []byte {
-	b := make([]byte, signatureSize+operatorIDSize+len(message))
-	copy(b[signatureOffset:], signature[:])
+// EncodeSignedSSVMessage serializes the message, op id and signature into bytes: signature size (2 bytes little endian), signature, op id and message
+func EncodeSignedSSVMessage(message []byte, operatorID OperatorID, signature []byte) ([]byte, error) {
+	if len(signature) > maxSignatureSize {
+		return nil, fmt.Errorf("unexpected signature size of %d", len(signature))
+	}
+	operatorIDOffset := signatureOffset + len(signature)
+	messageOffset := operatorIDOffset + operatorIDSize
+
+	b := make([]byte, messageOffset+len(message))
+	binary.LittleEndian.PutUint16(b, uint16(len(signature)))
+	copy(b[signatureOffset:], signature)
 	binary.LittleEndian.PutUint64(b[operatorIDOffset:], operatorID)
 	copy(b[messageOffset:], message)
-	return b
+	return b, nil
 }
 
 // DecodeSignedSSVMessage deserializes signed message bytes messsage, op id and a signature
-func DecodeSignedSSVMessage(encoded []byte) ([]byte, OperatorID, [256]byte, error) {
+func DecodeSignedSSVMessage(encoded []byte) ([]byte, OperatorID, []byte, error) {
+	if len(encoded) < signatureOffset {
+		return nil, 0, nil, fmt.Errorf("unexpected encoded message size of %d", len(encoded))
+	}
+	signatureSize := int(binary.LittleEndian.Uint16(encoded))
+	operatorIDOffset := signatureOffset + signatureSize
+	messageOffset := operatorIDOffset + operatorIDSize
 	if len(encoded) < messageOffset {
-		return nil, 0, [256]byte{}, fmt.Errorf("unexpected encoded message size of %d", len(encoded))
+		return nil, 0, nil, fmt.Errorf("unexpected encoded message size of %d", len(encoded))
+	}
+	if signatureSize > maxSignatureSize {
+		return nil, 0, nil, fmt.Errorf("unexpected signature size of %d", signatureSize)
 	}
 
 	message := encoded[messageOffset:]
 	operatorID := binary.LittleEndian.Uint64(encoded[operatorIDOffset : operatorIDOffset+operatorIDSize])
-	signature := [256]byte{}
-	copy(signature[:], encoded[signatureOffset:signatureOffset+signatureSize])
+	signature := make([]byte, signatureSize)
+	copy(signature, encoded[signatureOffset:operatorIDOffset])
+	return message, operatorID, signature, nil
+}
+
+// EncodeLegacySignedSSVMessage serializes the message, op id and signature into bytes in the layout before variable size signatures:
+// signature (256 bytes), op id and message. Only RSA signatures fit the layout
+func EncodeLegacySignedSSVMessage(message []byte, operatorID OperatorID, signature []byte) ([]byte, error) {
+	if len(signature) != legacySignatureSize {
+		return nil, fmt.Errorf("unexpected legacy signature size of %d", len(signature))
+	}
+	b := make([]byte, legacyMessageOffset+len(message))
+	copy(b, signature)
+	binary.LittleEndian.PutUint64(b[legacyOperatorIDOffset:], operatorID)
+	copy(b[legacyMessageOffset:], message)
+	return b, nil
+}
+
+// DecodeLegacySignedSSVMessage deserializes signed message bytes in the layout before variable size signatures, see EncodeLegacySignedSSVMessage
+func DecodeLegacySignedSSVMessage(encoded []byte) ([]byte, OperatorID, []byte, error) {
+	if len(encoded) < legacyMessageOffset {
+		return nil, 0, nil, fmt.Errorf("unexpected encoded message size of %d", len(encoded))
+	}
+	message := encoded[legacyMessageOffset:]
+	operatorID := binary.LittleEndian.Uint64(encoded[legacyOperatorIDOffset:legacyMessageOffset])
+	signature := make([]byte, legacySignatureSize)
+	copy(signature, encoded[:legacySignatureSize])
 	return message, operatorID, signature, nil
 }
 
@@ -3,11 +3,26 @@ package types
 // OperatorID is a unique ID for the node, used to create shares and verify msgs
 type OperatorID = uint64
 
+// OperatorKeyEpoch is the (phase0.Epoch) epoch an operator's SSV key is activated at
+type OperatorKeyEpoch = uint64
+
 // Operator represents an SSV operator node
 type Operator struct {
-	OperatorID        OperatorID
-	SharePubKey       []byte `ssz-size:"48"`
-	SSVOperatorPubKey []byte `ssz-size:"294"`
+	OperatorID  OperatorID
+	SharePubKey []byte `ssz-size:"48"`
+	// SSVOperatorPubKey is the operator's SSV public key encoded according to SSVOperatorKeyType
+	SSVOperatorPubKey []byte `ssz-max:"294"`
+	// Weight is the operator's voting power in the committee, 0 is treated as 1 (equal voting power)
+	Weight uint64 `json:",omitempty"`
+	// SSVOperatorKeyType is the type of the operator's SSV key, RSAOperatorKey (legacy) if not set
+	SSVOperatorKeyType OperatorKeyType `json:",omitempty"`
+	// SSVOperatorKeyEpoch is the epoch the operator's SSV key was activated at
+	SSVOperatorKeyEpoch OperatorKeyEpoch `json:",omitempty"`
+	// NextSSVOperatorPubKey is the operator's next SSV key during a key rotation (empty if not rotating), activated at NextSSVOperatorKeyEpoch.
+	// Both keys are valid during the rotation's overlap window, see SSVOperatorKeysAtEpoch
+	NextSSVOperatorPubKey   []byte           `ssz-max:"294" json:",omitempty"`
+	NextSSVOperatorKeyType  OperatorKeyType  `json:",omitempty"`
+	NextSSVOperatorKeyEpoch OperatorKeyEpoch `json:",omitempty"`
 }
 
 // GetSharePublicKey returns the share public key
@@ -20,6 +35,14 @@ func (n *Operator) GetSSVOperatorPublicKey() []byte {
 	return n.SSVOperatorPubKey
 }
 
+// GetWeight returns the operator's voting power in the committee
+func (n *Operator) GetWeight() uint64 {
+	if n.Weight == 0 {
+		return 1
+	}
+	return n.Weight
+}
+
 // GetID returns the node's ID
 func (n *Operator) GetID() OperatorID {
 	return n.OperatorID
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: e40484b5f771ab26e39f1bee0dad33c1c7c6dbfc4f0665ee6d370b5f83687e2c
+// Hash: 915a95c0adb8a3c96f73d382706a2978baf3f7a0076e016af39647386a4ead1d
 // Version: 0.1.3
 package types
 
@@ -15,6 +15,7 @@ func (o *Operator) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the Operator object to a target array
 func (o *Operator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
+	offset := int(104)
 
 	// Field (0) 'OperatorID'
 	dst = ssz.MarshalUint64(dst, uint64(o.OperatorID))
@@ -26,13 +27,43 @@ func (o *Operator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	dst = append(dst, o.SharePubKey...)
 
+	// Offset (2) 'SSVOperatorPubKey'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(o.SSVOperatorPubKey)
+
+	// Field (3) 'Weight'
+	dst = ssz.MarshalUint64(dst, o.Weight)
+
+	// Field (4) 'SSVOperatorKeyType'
+	dst = ssz.MarshalUint64(dst, uint64(o.SSVOperatorKeyType))
+
+	// Field (5) 'SSVOperatorKeyEpoch'
+	dst = ssz.MarshalUint64(dst, uint64(o.SSVOperatorKeyEpoch))
+
+	// Offset (6) 'NextSSVOperatorPubKey'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(o.NextSSVOperatorPubKey)
+
+	// Field (7) 'NextSSVOperatorKeyType'
+	dst = ssz.MarshalUint64(dst, uint64(o.NextSSVOperatorKeyType))
+
+	// Field (8) 'NextSSVOperatorKeyEpoch'
+	dst = ssz.MarshalUint64(dst, uint64(o.NextSSVOperatorKeyEpoch))
+
 	// Field (2) 'SSVOperatorPubKey'
-	if size := len(o.SSVOperatorPubKey); size != 294 {
+	if size := len(o.SSVOperatorPubKey); size > 294 {
 		err = ssz.ErrBytesLengthFn("Operator.SSVOperatorPubKey", size, 294)
 		return
 	}
 	dst = append(dst, o.SSVOperatorPubKey...)
 
+	// Field (6) 'NextSSVOperatorPubKey'
+	if size := len(o.NextSSVOperatorPubKey); size > 294 {
+		err = ssz.ErrBytesLengthFn("Operator.NextSSVOperatorPubKey", size, 294)
+		return
+	}
+	dst = append(dst, o.NextSSVOperatorPubKey...)
+
 	return
 }
 
@@ -40,10 +71,13 @@ func (o *Operator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (o *Operator) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 350 {
+	if size < 104 {
 		return ssz.ErrSize
 	}
 
+	tail := buf
+	var o2, o6 uint64
+
 	// Field (0) 'OperatorID'
 	o.OperatorID = OperatorID(ssz.UnmarshallUint64(buf[0:8]))
 
@@ -53,18 +87,71 @@ func (o *Operator) UnmarshalSSZ(buf []byte) error {
 	}
 	o.SharePubKey = append(o.SharePubKey, buf[8:56]...)
 
+	// Offset (2) 'SSVOperatorPubKey'
+	if o2 = ssz.ReadOffset(buf[56:60]); o2 > size {
+		return ssz.ErrOffset
+	}
+
+	if o2 < 104 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (3) 'Weight'
+	o.Weight = ssz.UnmarshallUint64(buf[60:68])
+
+	// Field (4) 'SSVOperatorKeyType'
+	o.SSVOperatorKeyType = OperatorKeyType(ssz.UnmarshallUint64(buf[68:76]))
+
+	// Field (5) 'SSVOperatorKeyEpoch'
+	o.SSVOperatorKeyEpoch = OperatorKeyEpoch(ssz.UnmarshallUint64(buf[76:84]))
+
+	// Offset (6) 'NextSSVOperatorPubKey'
+	if o6 = ssz.ReadOffset(buf[84:88]); o6 > size || o2 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Field (7) 'NextSSVOperatorKeyType'
+	o.NextSSVOperatorKeyType = OperatorKeyType(ssz.UnmarshallUint64(buf[88:96]))
+
+	// Field (8) 'NextSSVOperatorKeyEpoch'
+	o.NextSSVOperatorKeyEpoch = OperatorKeyEpoch(ssz.UnmarshallUint64(buf[96:104]))
+
 	// Field (2) 'SSVOperatorPubKey'
-	if cap(o.SSVOperatorPubKey) == 0 {
-		o.SSVOperatorPubKey = make([]byte, 0, len(buf[56:350]))
+	{
+		buf = tail[o2:o6]
+		if len(buf) > 294 {
+			return ssz.ErrBytesLength
+		}
+		if cap(o.SSVOperatorPubKey) == 0 {
+			o.SSVOperatorPubKey = make([]byte, 0, len(buf))
+		}
+		o.SSVOperatorPubKey = append(o.SSVOperatorPubKey, buf...)
 	}
-	o.SSVOperatorPubKey = append(o.SSVOperatorPubKey, buf[56:350]...)
 
+	// Field (6) 'NextSSVOperatorPubKey'
+	{
+		buf = tail[o6:]
+		if len(buf) > 294 {
+			return ssz.ErrBytesLength
+		}
+		if cap(o.NextSSVOperatorPubKey) == 0 {
+			o.NextSSVOperatorPubKey = make([]byte, 0, len(buf))
+		}
+		o.NextSSVOperatorPubKey = append(o.NextSSVOperatorPubKey, buf...)
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the Operator object
 func (o *Operator) SizeSSZ() (size int) {
-	size = 350
+	size = 104
+
+	// Field (2) 'SSVOperatorPubKey'
+	size += len(o.SSVOperatorPubKey)
+
+	// Field (6) 'NextSSVOperatorPubKey'
+	size += len(o.NextSSVOperatorPubKey)
+
 	return
 }
 
@@ -88,11 +175,43 @@ func (o *Operator) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	hh.PutBytes(o.SharePubKey)
 
 	// Field (2) 'SSVOperatorPubKey'
-	if size := len(o.SSVOperatorPubKey); size != 294 {
-		err = ssz.ErrBytesLengthFn("Operator.SSVOperatorPubKey", size, 294)
-		return
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(o.SSVOperatorPubKey))
+		if byteLen > 294 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(o.SSVOperatorPubKey)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (294+31)/32)
 	}
-	hh.PutBytes(o.SSVOperatorPubKey)
+
+	// Field (3) 'Weight'
+	hh.PutUint64(o.Weight)
+
+	// Field (4) 'SSVOperatorKeyType'
+	hh.PutUint64(uint64(o.SSVOperatorKeyType))
+
+	// Field (5) 'SSVOperatorKeyEpoch'
+	hh.PutUint64(uint64(o.SSVOperatorKeyEpoch))
+
+	// Field (6) 'NextSSVOperatorPubKey'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(o.NextSSVOperatorPubKey))
+		if byteLen > 294 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(o.NextSSVOperatorPubKey)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (294+31)/32)
+	}
+
+	// Field (7) 'NextSSVOperatorKeyType'
+	hh.PutUint64(uint64(o.NextSSVOperatorKeyType))
+
+	// Field (8) 'NextSSVOperatorKeyEpoch'
+	hh.PutUint64(uint64(o.NextSSVOperatorKeyEpoch))
 
 	hh.Merkleize(indx)
 	return
@@ -0,0 +1,267 @@
+package types
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/pkg/errors"
+)
+
+// OperatorKeyType is the type of the operator's SSV key, signing its SignedSSVMessages
+type OperatorKeyType uint64
+
+const (
+	// RSAOperatorKey is a PKIX encoded 2048 bit RSA public key, signing a PKCS1v15 signature (256 bytes) over sha256(data)
+	RSAOperatorKey OperatorKeyType = iota
+	// Ed25519OperatorKey is a 32 bytes Ed25519 public key, signing an Ed25519 signature (64 bytes) over the data
+	Ed25519OperatorKey
+	// Secp256k1OperatorKey is a 33 bytes compressed secp256k1 public key, signing a low-s ECDSA signature (64 bytes, r || s) over sha256(data)
+	Secp256k1OperatorKey
+)
+
+const (
+	rsaSignatureSize       = 256
+	ed25519SignatureSize   = ed25519.SignatureSize
+	secp256k1SignatureSize = 64
+)
+
+// SignatureSize returns the size of the key type's signatures, 0 for an unknown key type
+func (keyType OperatorKeyType) SignatureSize() int {
+	switch keyType {
+	case RSAOperatorKey:
+		return rsaSignatureSize
+	case Ed25519OperatorKey:
+		return ed25519SignatureSize
+	case Secp256k1OperatorKey:
+		return secp256k1SignatureSize
+	default:
+		return 0
+	}
+}
+
+// OperatorPubKey is a parsed SSV operator public key verifying the operator's signatures
+type OperatorPubKey interface {
+	// Verify returns error if the signature isn't the key's signature over data
+	Verify(data []byte, signature []byte) error
+}
+
+// ParseOperatorPubKey returns the parsed SSV operator public key of the key type
+func ParseOperatorPubKey(keyType OperatorKeyType, pubKey []byte) (OperatorPubKey, error) {
+	switch keyType {
+	case RSAOperatorKey:
+		parsed, err := x509.ParsePKIXPublicKey(pubKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse rsa public key")
+		}
+		pk, ok := parsed.(*rsa.PublicKey)
+		if !ok {
+			return nil, errors.New("could not parse rsa public key")
+		}
+		return &rsaOperatorPubKey{pk: pk}, nil
+	case Ed25519OperatorKey:
+		if len(pubKey) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 public key size")
+		}
+		return ed25519OperatorPubKey(pubKey), nil
+	case Secp256k1OperatorKey:
+		if len(pubKey) != secp256k1.PubKeyBytesLenCompressed {
+			return nil, errors.New("invalid secp256k1 public key size")
+		}
+		pk, err := secp256k1.ParsePubKey(pubKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse secp256k1 public key")
+		}
+		return &secp256k1OperatorPubKey{pk: pk}, nil
+	default:
+		return nil, errors.New("unknown operator key type")
+	}
+}
+
+// VerifyOperatorSignature returns error if the signature isn't the operator's signature over data, by its current SSV operator key
+func VerifyOperatorSignature(operator *Operator, data []byte, signature []byte) error {
+	pk, err := ParseOperatorPubKey(operator.SSVOperatorKeyType, operator.SSVOperatorPubKey)
+	if err != nil {
+		return err
+	}
+	return pk.Verify(data, signature)
+}
+
+// KeyRotationOverlapEpochs is the number of epochs on each side of a key rotation's activation epoch during which both the
+// operator's current and next SSV operator keys are valid: messages signed with the current key are still in flight after the
+// activation, and peers whose clock is ahead switch to the next key before it
+const KeyRotationOverlapEpochs = 1
+
+// OperatorKey is an SSV operator public key of a key type
+type OperatorKey struct {
+	KeyType OperatorKeyType
+	PubKey  []byte
+	// Next is true for the operator's next SSV operator key (NextSSVOperatorPubKey)
+	Next bool
+}
+
+// IsRotating returns true if the operator has a next SSV operator key
+func (n *Operator) IsRotating() bool {
+	return len(n.NextSSVOperatorPubKey) > 0
+}
+
+// SSVOperatorKeysAtEpoch returns the operator's SSV operator keys valid at the epoch, the current key first.
+// The current key is valid from KeyRotationOverlapEpochs before SSVOperatorKeyEpoch until KeyRotationOverlapEpochs after
+// NextSSVOperatorKeyEpoch (if rotating), the next key from KeyRotationOverlapEpochs before NextSSVOperatorKeyEpoch
+func (n *Operator) SSVOperatorKeysAtEpoch(epoch phase0.Epoch) []*OperatorKey {
+	ret := make([]*OperatorKey, 0, 2)
+	keyEpoch := OperatorKeyEpoch(epoch)
+	if keyEpoch+KeyRotationOverlapEpochs >= n.SSVOperatorKeyEpoch &&
+		(!n.IsRotating() || keyEpoch < n.NextSSVOperatorKeyEpoch+KeyRotationOverlapEpochs) {
+		ret = append(ret, &OperatorKey{KeyType: n.SSVOperatorKeyType, PubKey: n.SSVOperatorPubKey})
+	}
+	if n.IsRotating() && keyEpoch+KeyRotationOverlapEpochs >= n.NextSSVOperatorKeyEpoch {
+		ret = append(ret, &OperatorKey{KeyType: n.NextSSVOperatorKeyType, PubKey: n.NextSSVOperatorPubKey, Next: true})
+	}
+	return ret
+}
+
+// VerifyOperatorSignatureAtEpoch returns error if the signature isn't the operator's signature over data by any of its SSV operator
+// keys valid at the epoch (see SSVOperatorKeysAtEpoch), the first valid key's error if none matches
+func VerifyOperatorSignatureAtEpoch(operator *Operator, epoch phase0.Epoch, data []byte, signature []byte) error {
+	var firstErr error
+	for _, key := range operator.SSVOperatorKeysAtEpoch(epoch) {
+		pk, err := ParseOperatorPubKey(key.KeyType, key.PubKey)
+		if err == nil {
+			err = pk.Verify(data, signature)
+		}
+		if err == nil {
+			return nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr == nil {
+		return errors.New("no operator key valid at epoch")
+	}
+	return firstErr
+}
+
+type rsaOperatorPubKey struct {
+	pk *rsa.PublicKey
+}
+
+func (key *rsaOperatorPubKey) Verify(data []byte, signature []byte) error {
+	if len(signature) != rsaSignatureSize {
+		return errors.New("invalid rsa signature size")
+	}
+	hash := sha256.Sum256(data)
+	return rsa.VerifyPKCS1v15(key.pk, crypto.SHA256, hash[:], signature)
+}
+
+type ed25519OperatorPubKey ed25519.PublicKey
+
+func (key ed25519OperatorPubKey) Verify(data []byte, signature []byte) error {
+	if len(signature) != ed25519SignatureSize {
+		return errors.New("invalid ed25519 signature size")
+	}
+	if !ed25519.Verify(ed25519.PublicKey(key), data, signature) {
+		return errors.New("invalid ed25519 signature")
+	}
+	return nil
+}
+
+type secp256k1OperatorPubKey struct {
+	pk *secp256k1.PublicKey
+}
+
+func (key *secp256k1OperatorPubKey) Verify(data []byte, signature []byte) error {
+	if len(signature) != secp256k1SignatureSize {
+		return errors.New("invalid secp256k1 signature size")
+	}
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(signature[:32]); overflow || r.IsZero() {
+		return errors.New("invalid secp256k1 signature")
+	}
+	// a high s is rejected, otherwise (r, n-s) would be another valid signature of the message
+	if overflow := s.SetByteSlice(signature[32:]); overflow || s.IsZero() || s.IsOverHalfOrder() {
+		return errors.New("invalid secp256k1 signature")
+	}
+	hash := sha256.Sum256(data)
+	if !ecdsa.NewSignature(&r, &s).Verify(hash[:], key.pk) {
+		return errors.New("invalid secp256k1 signature")
+	}
+	return nil
+}
+
+// RotatingOperatorSigner signs SignedSSVMessages with the operator's current SSV operator key until the next key's activation epoch,
+// and with the next key from it on
+type RotatingOperatorSigner struct {
+	current         OperatorSigner
+	next            OperatorSigner
+	activationEpoch phase0.Epoch
+	currentEpoch    func() phase0.Epoch
+}
+
+// NewRotatingOperatorSigner returns a signer switching from the current to the next signer at the activation epoch,
+// currentEpoch returns the epoch messages are signed at
+func NewRotatingOperatorSigner(current, next OperatorSigner, activationEpoch phase0.Epoch, currentEpoch func() phase0.Epoch) *RotatingOperatorSigner {
+	return &RotatingOperatorSigner{
+		current:         current,
+		next:            next,
+		activationEpoch: activationEpoch,
+		currentEpoch:    currentEpoch,
+	}
+}
+
+func (signer *RotatingOperatorSigner) SignSSVMessage(data []byte) ([]byte, error) {
+	if signer.currentEpoch() >= signer.activationEpoch {
+		return signer.next.SignSSVMessage(data)
+	}
+	return signer.current.SignSSVMessage(data)
+}
+
+// RSAOperatorSigner signs SignedSSVMessages with an RSA operator key (RSAOperatorKey)
+type RSAOperatorSigner struct {
+	sk *rsa.PrivateKey
+}
+
+func NewRSAOperatorSigner(sk *rsa.PrivateKey) *RSAOperatorSigner {
+	return &RSAOperatorSigner{sk: sk}
+}
+
+func (signer *RSAOperatorSigner) SignSSVMessage(data []byte) ([]byte, error) {
+	hash := sha256.Sum256(data)
+	return rsa.SignPKCS1v15(rand.Reader, signer.sk, crypto.SHA256, hash[:])
+}
+
+// Ed25519OperatorSigner signs SignedSSVMessages with an Ed25519 operator key (Ed25519OperatorKey)
+type Ed25519OperatorSigner struct {
+	sk ed25519.PrivateKey
+}
+
+func NewEd25519OperatorSigner(sk ed25519.PrivateKey) *Ed25519OperatorSigner {
+	return &Ed25519OperatorSigner{sk: sk}
+}
+
+func (signer *Ed25519OperatorSigner) SignSSVMessage(data []byte) ([]byte, error) {
+	return ed25519.Sign(signer.sk, data), nil
+}
+
+// Secp256k1OperatorSigner signs SignedSSVMessages with a secp256k1 operator key (Secp256k1OperatorKey)
+type Secp256k1OperatorSigner struct {
+	sk *secp256k1.PrivateKey
+}
+
+func NewSecp256k1OperatorSigner(sk *secp256k1.PrivateKey) *Secp256k1OperatorSigner {
+	return &Secp256k1OperatorSigner{sk: sk}
+}
+
+func (signer *Secp256k1OperatorSigner) SignSSVMessage(data []byte) ([]byte, error) {
+	hash := sha256.Sum256(data)
+	// the compact signature is the recovery code followed by r || s (RFC6979 nonce, low s)
+	compact := ecdsa.SignCompact(signer.sk, hash[:], true)
+	return compact[1:], nil
+}
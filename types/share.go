@@ -1,5 +1,14 @@
 package types
 
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+)
+
 // Share holds all info about the QBFT/ SSV Committee for msg signing and verification
 type Share struct {
 	OperatorID            OperatorID
@@ -24,10 +33,209 @@ func (share *Share) HasPartialQuorum(cnt int) bool {
 	return uint64(cnt) >= share.PartialQuorum
 }
 
+// OperatorWeight returns the voting power of the committee operator, 0 if the operator isn't in the committee
+func (share *Share) OperatorWeight(operatorID OperatorID) uint64 {
+	for _, operator := range share.Committee {
+		if operator.OperatorID == operatorID {
+			return operator.GetWeight()
+		}
+	}
+	return 0
+}
+
+// TotalWeight returns the committee's total voting power
+func (share *Share) TotalWeight() uint64 {
+	ret := uint64(0)
+	for _, operator := range share.Committee {
+		ret += operator.GetWeight()
+	}
+	return ret
+}
+
+// SignersWeight returns the voting power of the unique signers
+func (share *Share) SignersWeight(signers []OperatorID) uint64 {
+	unique := make(map[OperatorID]bool)
+	ret := uint64(0)
+	for _, signer := range signers {
+		if unique[signer] {
+			continue
+		}
+		unique[signer] = true
+		ret += share.OperatorWeight(signer)
+	}
+	return ret
+}
+
+// SignersHaveQuorum returns true if the voting power of the unique signers is at least the quorum weight.
+// For a committee with equal voting power it's equivalent to HasQuorum(len(signers)) for unique committee signers
+func (share *Share) SignersHaveQuorum(signers []OperatorID) bool {
+	return share.SignersWeight(signers) >= share.Quorum
+}
+
+// SignersHavePartialQuorum returns true if the voting power of the unique signers is at least the partial quorum weight
+func (share *Share) SignersHavePartialQuorum(signers []OperatorID) bool {
+	return share.SignersWeight(signers) >= share.PartialQuorum
+}
+
+// OperatorWeights returns the committee operators' voting power, nil if all operators have equal voting power
+func (share *Share) OperatorWeights() map[OperatorID]uint64 {
+	weighted := false
+	ret := make(map[OperatorID]uint64)
+	for _, operator := range share.Committee {
+		ret[operator.OperatorID] = operator.GetWeight()
+		if operator.GetWeight() != 1 {
+			weighted = true
+		}
+	}
+	if !weighted {
+		return nil
+	}
+	return ret
+}
+
+// MinQuorumSize returns the min number of operators whose voting power reaches quorum (the heaviest operators).
+// The validator key must be split with a threshold no higher than it for any quorum of signers to reconstruct a signature.
+func (share *Share) MinQuorumSize() uint64 {
+	weights := make([]uint64, 0, len(share.Committee))
+	for _, operator := range share.Committee {
+		weights = append(weights, operator.GetWeight())
+	}
+	sort.Slice(weights, func(i, j int) bool {
+		return weights[i] > weights[j]
+	})
+
+	total := uint64(0)
+	for i, weight := range weights {
+		total += weight
+		if total >= share.Quorum {
+			return uint64(i + 1)
+		}
+	}
+	return uint64(len(weights))
+}
+
+// ComputeQuorum returns the quorum and partial quorum weights tolerating the max faulty voting power f, where 3f < totalWeight.
+// Quorum q = floor((totalWeight+f)/2)+1 guarantees any two quorums intersect with more than f voting power (2q-totalWeight > f),
+// partial quorum f+1 guarantees at least one honest operator. For equal voting power (totalWeight = 3f+1) it's 2f+1 and f+1.
+// The fault model counts faulty voting power, not operators: no single operator may hold more than f voting power, otherwise that one
+// faulty operator exceeds the tolerated f (Share.Validate rejects such committees).
+func ComputeQuorum(totalWeight uint64) (quorum uint64, partialQuorum uint64) {
+	if totalWeight == 0 {
+		return 0, 0
+	}
+	f := (totalWeight - 1) / 3
+	return (totalWeight+f)/2 + 1, f + 1
+}
+
+// Validate returns error if the share isn't consistent: a 3f+1 committee sorted by unique operator IDs including the share's operator,
+// no operator holding more than the max faulty voting power f, quorums matching the committee's voting power and share public keys any
+// quorum of which interpolates to the validator public key.
+func (share *Share) Validate() error {
+	if len(share.Committee) == 0 || len(share.Committee) > 13 || len(share.Committee)%3 != 1 {
+		return errors.New("invalid committee size")
+	}
+	for i, operator := range share.Committee {
+		if operator.OperatorID == 0 {
+			return errors.New("invalid operator id")
+		}
+		if i > 0 && operator.OperatorID <= share.Committee[i-1].OperatorID {
+			return errors.New("committee not sorted by unique operator ids")
+		}
+		if operator.IsRotating() && operator.NextSSVOperatorKeyEpoch <= operator.SSVOperatorKeyEpoch {
+			return errors.New("next operator key not activated after current key")
+		}
+	}
+	maxWeight := (share.TotalWeight() - 1) / 3
+	for _, operator := range share.Committee {
+		if operator.GetWeight() > maxWeight {
+			return errors.Errorf("operator %d voting power exceeds the max faulty voting power %d", operator.OperatorID, maxWeight)
+		}
+	}
+	committeeShare := share.committeeOperator(share.OperatorID)
+	if committeeShare == nil {
+		return errors.New("operator not in committee")
+	}
+	if !bytes.Equal(committeeShare.SharePubKey, share.SharePubKey) {
+		return errors.New("share public key doesn't match committee")
+	}
+
+	quorum, partialQuorum := ComputeQuorum(share.TotalWeight())
+	if share.Quorum != quorum || share.PartialQuorum != partialQuorum {
+		return errors.New("invalid quorum")
+	}
+
+	return share.validateSharePubKeys()
+}
+
+// validateSharePubKeys returns error if any quorum of the committee's share public keys doesn't interpolate to the validator public key.
+// The smallest quorum subsets have MinQuorumSize operators (m): if the first m share public keys interpolate to the validator public key
+// and so does every other operator's with the first m-1, all the share public keys lie on the same polynomial of degree < m (it
+// agrees with the first m-1 and the validator public key on m points). Any quorum subset has at least m points on it, interpolating to
+// the validator public key.
+func (share *Share) validateSharePubKeys() error {
+	InitBLS()
+
+	validatorPK := &bls.PublicKey{}
+	if err := validatorPK.Deserialize(share.ValidatorPubKey[:]); err != nil {
+		return errors.Wrap(err, "invalid validator public key")
+	}
+	pks := make([]bls.PublicKey, len(share.Committee))
+	ids := make([]bls.ID, len(share.Committee))
+	for i, operator := range share.Committee {
+		if err := pks[i].Deserialize(operator.SharePubKey); err != nil {
+			return errors.Wrapf(err, "invalid operator %d share public key", operator.OperatorID)
+		}
+		if err := ids[i].SetDecString(fmt.Sprintf("%d", operator.OperatorID)); err != nil {
+			return errors.Wrap(err, "could not set operator id")
+		}
+	}
+
+	m := int(share.MinQuorumSize())
+	interpolatesToValidator := func(subsetPKs []bls.PublicKey, subsetIDs []bls.ID) bool {
+		interpolated := &bls.PublicKey{}
+		if err := interpolated.Recover(subsetPKs, subsetIDs); err != nil {
+			return false
+		}
+		return interpolated.IsEqual(validatorPK)
+	}
+	if !interpolatesToValidator(pks[:m], ids[:m]) {
+		return errors.New("share public keys don't interpolate to validator public key")
+	}
+	for j := m; j < len(pks); j++ {
+		subsetPKs := append(append([]bls.PublicKey{}, pks[:m-1]...), pks[j])
+		subsetIDs := append(append([]bls.ID{}, ids[:m-1]...), ids[j])
+		if !interpolatesToValidator(subsetPKs, subsetIDs) {
+			return errors.Errorf("operator %d share public key doesn't interpolate to validator public key", share.Committee[j].OperatorID)
+		}
+	}
+	return nil
+}
+
+// committeeOperator returns the committee operator, nil if not found
+func (share *Share) committeeOperator(operatorID OperatorID) *Operator {
+	for _, operator := range share.Committee {
+		if operator.OperatorID == operatorID {
+			return operator
+		}
+	}
+	return nil
+}
+
 func (share *Share) Encode() ([]byte, error) {
 	return share.MarshalSSZ()
 }
 
+// Decode decodes the share, a share which doesn't decode is decoded with the legacy layout (see legacyShare).
+// The layouts don't overlap, a legacy committee's operators start with their fixed size PKIX encoded RSA key where an offset is expected
 func (share *Share) Decode(data []byte) error {
-	return share.UnmarshalSSZ(data)
+	err := share.UnmarshalSSZ(data)
+	if err == nil {
+		return nil
+	}
+	legacy := &legacyShare{}
+	if legacyErr := legacy.UnmarshalSSZ(data); legacyErr != nil {
+		return err
+	}
+	*share = *legacy.toShare()
+	return nil
 }
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: d62e6a290e2d192b3ca3b3ed67d59f367705729f024c19852bd7b3626c48211c
+// Hash: 75c11091c0795428b91b20ea7c016626ee6b36d7120b7deb6e0c7a7cb8291984
 // Version: 0.1.3
 package types
 
@@ -36,7 +36,10 @@ func (s *Share) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 	// Offset (3) 'Committee'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(s.Committee) * 350
+	for ii := 0; ii < len(s.Committee); ii++ {
+		offset += 4
+		offset += s.Committee[ii].SizeSSZ()
+	}
 
 	// Field (4) 'DomainType'
 	dst = append(dst, s.DomainType[:]...)
@@ -56,6 +59,13 @@ func (s *Share) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		err = ssz.ErrListTooBigFn("Share.Committee", size, 13)
 		return
 	}
+	{
+		offset = 4 * len(s.Committee)
+		for ii := 0; ii < len(s.Committee); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += s.Committee[ii].SizeSSZ()
+		}
+	}
 	for ii := 0; ii < len(s.Committee); ii++ {
 		if dst, err = s.Committee[ii].MarshalSSZTo(dst); err != nil {
 			return
@@ -115,18 +125,22 @@ func (s *Share) UnmarshalSSZ(buf []byte) error {
 	// Field (3) 'Committee'
 	{
 		buf = tail[o3:]
-		num, err := ssz.DivideInt2(len(buf), 350, 13)
+		num, err := ssz.DecodeDynamicLength(buf, 13)
 		if err != nil {
 			return err
 		}
 		s.Committee = make([]*Operator, num)
-		for ii := 0; ii < num; ii++ {
-			if s.Committee[ii] == nil {
-				s.Committee[ii] = new(Operator)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if s.Committee[indx] == nil {
+				s.Committee[indx] = new(Operator)
 			}
-			if err = s.Committee[ii].UnmarshalSSZ(buf[ii*350 : (ii+1)*350]); err != nil {
+			if err = s.Committee[indx].UnmarshalSSZ(buf); err != nil {
 				return err
 			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 	}
 	return err
@@ -137,7 +151,10 @@ func (s *Share) SizeSSZ() (size int) {
 	size = 164
 
 	// Field (3) 'Committee'
-	size += len(s.Committee) * 350
+	for ii := 0; ii < len(s.Committee); ii++ {
+		size += 4
+		size += s.Committee[ii].SizeSSZ()
+	}
 
 	return
 }
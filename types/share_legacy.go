@@ -0,0 +1,45 @@
+package types
+
+// legacyOperator is an Operator in the legacy SSZ layout, before operator voting power (Weight), key types (SSVOperatorKeyType)
+// and key rotation (the key epochs and next key) were added. The SSV key is a fixed size PKIX encoded RSA key
+type legacyOperator struct {
+	OperatorID        OperatorID
+	SharePubKey       []byte `ssz-size:"48"`
+	SSVOperatorPubKey []byte `ssz-size:"294"`
+}
+
+// legacyShare is a Share in the legacy SSZ layout, its committee's operators are legacyOperators
+type legacyShare struct {
+	OperatorID            OperatorID
+	ValidatorPubKey       ValidatorPK       `ssz-size:"48"`
+	SharePubKey           []byte            `ssz-size:"48"`
+	Committee             []*legacyOperator `ssz-max:"13"`
+	Quorum, PartialQuorum uint64
+	DomainType            DomainType `ssz-size:"4"`
+	FeeRecipientAddress   [20]byte   `ssz-size:"20"`
+	Graffiti              []byte     `ssz-size:"32"`
+}
+
+// toShare returns the share with the operators' legacy defaults: equal voting power, an RSA key activated at epoch 0 and no next key
+func (legacy *legacyShare) toShare() *Share {
+	share := &Share{
+		OperatorID:          legacy.OperatorID,
+		ValidatorPubKey:     legacy.ValidatorPubKey,
+		SharePubKey:         legacy.SharePubKey,
+		Committee:           make([]*Operator, 0, len(legacy.Committee)),
+		Quorum:              legacy.Quorum,
+		PartialQuorum:       legacy.PartialQuorum,
+		DomainType:          legacy.DomainType,
+		FeeRecipientAddress: legacy.FeeRecipientAddress,
+		Graffiti:            legacy.Graffiti,
+	}
+	for _, operator := range legacy.Committee {
+		share.Committee = append(share.Committee, &Operator{
+			OperatorID:         operator.OperatorID,
+			SharePubKey:        operator.SharePubKey,
+			SSVOperatorPubKey:  operator.SSVOperatorPubKey,
+			SSVOperatorKeyType: RSAOperatorKey,
+		})
+	}
+	return share
+}
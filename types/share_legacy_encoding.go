@@ -0,0 +1,303 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: fcaa72a1a7a212e305638b228cf63c68a0ea4f5f462ce7b272ca65b3e7fa2bc2
+// Version: 0.1.3
+package types
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the legacyOperator object
+func (l *legacyOperator) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the legacyOperator object to a target array
+func (l *legacyOperator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'OperatorID'
+	dst = ssz.MarshalUint64(dst, uint64(l.OperatorID))
+
+	// Field (1) 'SharePubKey'
+	if size := len(l.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("legacyOperator.SharePubKey", size, 48)
+		return
+	}
+	dst = append(dst, l.SharePubKey...)
+
+	// Field (2) 'SSVOperatorPubKey'
+	if size := len(l.SSVOperatorPubKey); size != 294 {
+		err = ssz.ErrBytesLengthFn("legacyOperator.SSVOperatorPubKey", size, 294)
+		return
+	}
+	dst = append(dst, l.SSVOperatorPubKey...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the legacyOperator object
+func (l *legacyOperator) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 350 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'OperatorID'
+	l.OperatorID = OperatorID(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'SharePubKey'
+	if cap(l.SharePubKey) == 0 {
+		l.SharePubKey = make([]byte, 0, len(buf[8:56]))
+	}
+	l.SharePubKey = append(l.SharePubKey, buf[8:56]...)
+
+	// Field (2) 'SSVOperatorPubKey'
+	if cap(l.SSVOperatorPubKey) == 0 {
+		l.SSVOperatorPubKey = make([]byte, 0, len(buf[56:350]))
+	}
+	l.SSVOperatorPubKey = append(l.SSVOperatorPubKey, buf[56:350]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the legacyOperator object
+func (l *legacyOperator) SizeSSZ() (size int) {
+	size = 350
+	return
+}
+
+// HashTreeRoot ssz hashes the legacyOperator object
+func (l *legacyOperator) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the legacyOperator object with a hasher
+func (l *legacyOperator) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'OperatorID'
+	hh.PutUint64(uint64(l.OperatorID))
+
+	// Field (1) 'SharePubKey'
+	if size := len(l.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("legacyOperator.SharePubKey", size, 48)
+		return
+	}
+	hh.PutBytes(l.SharePubKey)
+
+	// Field (2) 'SSVOperatorPubKey'
+	if size := len(l.SSVOperatorPubKey); size != 294 {
+		err = ssz.ErrBytesLengthFn("legacyOperator.SSVOperatorPubKey", size, 294)
+		return
+	}
+	hh.PutBytes(l.SSVOperatorPubKey)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the legacyOperator object
+func (l *legacyOperator) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(l)
+}
+
+// MarshalSSZ ssz marshals the legacyShare object
+func (l *legacyShare) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the legacyShare object to a target array
+func (l *legacyShare) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(164)
+
+	// Field (0) 'OperatorID'
+	dst = ssz.MarshalUint64(dst, uint64(l.OperatorID))
+
+	// Field (1) 'ValidatorPubKey'
+	if size := len(l.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("legacyShare.ValidatorPubKey", size, 48)
+		return
+	}
+	dst = append(dst, l.ValidatorPubKey...)
+
+	// Field (2) 'SharePubKey'
+	if size := len(l.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("legacyShare.SharePubKey", size, 48)
+		return
+	}
+	dst = append(dst, l.SharePubKey...)
+
+	// Offset (3) 'Committee'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(l.Committee) * 350
+
+	// Field (4) 'DomainType'
+	dst = append(dst, l.DomainType[:]...)
+
+	// Field (5) 'FeeRecipientAddress'
+	dst = append(dst, l.FeeRecipientAddress[:]...)
+
+	// Field (6) 'Graffiti'
+	if size := len(l.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("legacyShare.Graffiti", size, 32)
+		return
+	}
+	dst = append(dst, l.Graffiti...)
+
+	// Field (3) 'Committee'
+	if size := len(l.Committee); size > 13 {
+		err = ssz.ErrListTooBigFn("legacyShare.Committee", size, 13)
+		return
+	}
+	for ii := 0; ii < len(l.Committee); ii++ {
+		if dst, err = l.Committee[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the legacyShare object
+func (l *legacyShare) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 164 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3 uint64
+
+	// Field (0) 'OperatorID'
+	l.OperatorID = OperatorID(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'ValidatorPubKey'
+	if cap(l.ValidatorPubKey) == 0 {
+		l.ValidatorPubKey = make([]byte, 0, len(buf[8:56]))
+	}
+	l.ValidatorPubKey = append(l.ValidatorPubKey, buf[8:56]...)
+
+	// Field (2) 'SharePubKey'
+	if cap(l.SharePubKey) == 0 {
+		l.SharePubKey = make([]byte, 0, len(buf[56:104]))
+	}
+	l.SharePubKey = append(l.SharePubKey, buf[56:104]...)
+
+	// Offset (3) 'Committee'
+	if o3 = ssz.ReadOffset(buf[104:108]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 < 164 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (4) 'DomainType'
+	copy(l.DomainType[:], buf[108:112])
+
+	// Field (5) 'FeeRecipientAddress'
+	copy(l.FeeRecipientAddress[:], buf[112:132])
+
+	// Field (6) 'Graffiti'
+	if cap(l.Graffiti) == 0 {
+		l.Graffiti = make([]byte, 0, len(buf[132:164]))
+	}
+	l.Graffiti = append(l.Graffiti, buf[132:164]...)
+
+	// Field (3) 'Committee'
+	{
+		buf = tail[o3:]
+		num, err := ssz.DivideInt2(len(buf), 350, 13)
+		if err != nil {
+			return err
+		}
+		l.Committee = make([]*legacyOperator, num)
+		for ii := 0; ii < num; ii++ {
+			if l.Committee[ii] == nil {
+				l.Committee[ii] = new(legacyOperator)
+			}
+			if err = l.Committee[ii].UnmarshalSSZ(buf[ii*350 : (ii+1)*350]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the legacyShare object
+func (l *legacyShare) SizeSSZ() (size int) {
+	size = 164
+
+	// Field (3) 'Committee'
+	size += len(l.Committee) * 350
+
+	return
+}
+
+// HashTreeRoot ssz hashes the legacyShare object
+func (l *legacyShare) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the legacyShare object with a hasher
+func (l *legacyShare) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'OperatorID'
+	hh.PutUint64(uint64(l.OperatorID))
+
+	// Field (1) 'ValidatorPubKey'
+	if size := len(l.ValidatorPubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("legacyShare.ValidatorPubKey", size, 48)
+		return
+	}
+	hh.PutBytes(l.ValidatorPubKey)
+
+	// Field (2) 'SharePubKey'
+	if size := len(l.SharePubKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("legacyShare.SharePubKey", size, 48)
+		return
+	}
+	hh.PutBytes(l.SharePubKey)
+
+	// Field (3) 'Committee'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(l.Committee))
+		if num > 13 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range l.Committee {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 13)
+	}
+
+	// Field (4) 'DomainType'
+	hh.PutBytes(l.DomainType[:])
+
+	// Field (5) 'FeeRecipientAddress'
+	hh.PutBytes(l.FeeRecipientAddress[:])
+
+	// Field (6) 'Graffiti'
+	if size := len(l.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("legacyShare.Graffiti", size, 32)
+		return
+	}
+	hh.PutBytes(l.Graffiti)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the legacyShare object
+func (l *legacyShare) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(l)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShare_DecodeLegacy(t *testing.T) {
+	legacy := &legacyShare{
+		OperatorID:          1,
+		ValidatorPubKey:     bytes.Repeat([]byte{1}, 48),
+		SharePubKey:         bytes.Repeat([]byte{2}, 48),
+		DomainType:          DomainType{0, 0, 5, 2},
+		FeeRecipientAddress: [20]byte{3},
+		Graffiti:            bytes.Repeat([]byte{4}, 32),
+	}
+	for id := OperatorID(1); id <= 4; id++ {
+		sk, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		pk, err := x509.MarshalPKIXPublicKey(&sk.PublicKey)
+		require.NoError(t, err)
+		legacy.Committee = append(legacy.Committee, &legacyOperator{
+			OperatorID:        id,
+			SharePubKey:       bytes.Repeat([]byte{byte(id)}, 48),
+			SSVOperatorPubKey: pk,
+		})
+	}
+	data, err := legacy.MarshalSSZ()
+	require.NoError(t, err)
+
+	// the current layout doesn't decode the legacy share
+	require.Error(t, (&Share{}).UnmarshalSSZ(data))
+
+	share := &Share{}
+	require.NoError(t, share.Decode(data))
+	require.EqualValues(t, legacy.toShare(), share)
+	for i, operator := range share.Committee {
+		require.EqualValues(t, legacy.Committee[i].SSVOperatorPubKey, operator.SSVOperatorPubKey)
+		require.EqualValues(t, 1, operator.GetWeight())
+		require.EqualValues(t, RSAOperatorKey, operator.SSVOperatorKeyType)
+		require.Empty(t, operator.NextSSVOperatorPubKey)
+	}
+
+	// re-encoded with the current layout
+	byts, err := share.Encode()
+	require.NoError(t, err)
+	decoded := &Share{}
+	require.NoError(t, decoded.UnmarshalSSZ(byts))
+	reencoded, err := decoded.Encode()
+	require.NoError(t, err)
+	require.EqualValues(t, byts, reencoded)
+
+	// neither layout decodes a truncated share
+	require.Error(t, (&Share{}).Decode(data[:len(data)-1]))
+}
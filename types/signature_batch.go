@@ -0,0 +1,258 @@
+package types
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+)
+
+// SignatureBatch verifies BLS signatures over 32 bytes roots at once: the signatures are checked with a random linear
+// combination of their pairings (a single multi-pairing), so a batch is valid only if, with overwhelming probability, every
+// signature is. An invalid batch is bisected to find its invalid signatures
+type SignatureBatch struct {
+	sigs  []bls.Sign
+	pks   []bls.PublicKey
+	roots []byte
+	keys  [][32]byte
+	// cache holds signatures already verified, see UseCache
+	cache *BLSSignatureCache
+}
+
+// NewSignatureBatch returns an empty batch
+func NewSignatureBatch() *SignatureBatch {
+	InitBLS()
+	return &SignatureBatch{}
+}
+
+// UseCache sets the cache of verified signatures (nil for none), Verify doesn't verify the cached signatures again and caches
+// the valid ones
+func (b *SignatureBatch) UseCache(cache *BLSSignatureCache) *SignatureBatch {
+	b.cache = cache
+	return b
+}
+
+// Len returns the number of signatures in the batch
+func (b *SignatureBatch) Len() int {
+	return len(b.sigs)
+}
+
+// Add adds a signature over the root by the aggregated public keys (a single key for a partial signature), error if any of
+// them can't be deserialized in which case nothing is added
+func (b *SignatureBatch) Add(signature Signature, pubKeys [][]byte, root [32]byte) error {
+	sig := bls.Sign{}
+	if err := sig.Deserialize(signature); err != nil {
+		return errors.Wrap(err, "failed to deserialize signature")
+	}
+	if len(pubKeys) == 0 {
+		return errors.New("no public keys found")
+	}
+	aggPK := bls.PublicKey{}
+	for i, pkByts := range pubKeys {
+		pk := bls.PublicKey{}
+		if err := pk.Deserialize(pkByts); err != nil {
+			return errors.Wrap(err, "failed to deserialize public key")
+		}
+		if i == 0 {
+			aggPK = pk
+		} else {
+			aggPK.Add(&pk)
+		}
+	}
+
+	b.sigs = append(b.sigs, sig)
+	b.pks = append(b.pks, aggPK)
+	b.roots = append(b.roots, root[:]...)
+	b.keys = append(b.keys, blsSignatureKey(signature, pubKeys, root))
+	return nil
+}
+
+// AddByOperators adds a message's signature by its signers, as verified by Signature.VerifyByOperators
+func (b *SignatureBatch) AddByOperators(data MessageSignature, domain DomainType, sigType SignatureType, operators []*Operator) error {
+	pks := make([][]byte, 0, len(data.GetSigners()))
+	for _, id := range data.GetSigners() {
+		found := false
+		for _, n := range operators {
+			if id == n.GetID() {
+				pks = append(pks, n.GetSharePublicKey())
+				found = true
+			}
+		}
+		if !found {
+			return errors.New("unknown signer")
+		}
+	}
+
+	computedRoot, err := ComputeSigningRoot(data, ComputeSignatureDomain(domain, sigType))
+	if err != nil {
+		return errors.Wrap(err, "could not compute signing root")
+	}
+	return b.Add(data.GetSignature(), pks, computedRoot)
+}
+
+// Verify returns the indexes (in the order added) of the batch's invalid signatures, empty if all are valid.
+// With a cache (see UseCache) cached signatures aren't verified again and the valid ones are cached
+func (b *SignatureBatch) Verify() []int {
+	if b.cache == nil {
+		return b.bisect(0, len(b.sigs))
+	}
+
+	pending := &SignatureBatch{}
+	indexes := make([]int, 0, len(b.sigs))
+	for i := range b.sigs {
+		if b.cache.verified.has(b.keys[i]) {
+			continue
+		}
+		pending.sigs = append(pending.sigs, b.sigs[i])
+		pending.pks = append(pending.pks, b.pks[i])
+		pending.roots = append(pending.roots, b.roots[i*32:(i+1)*32]...)
+		indexes = append(indexes, i)
+	}
+
+	invalid := pending.bisect(0, len(pending.sigs))
+	isInvalid := make(map[int]bool, len(invalid))
+	for j, i := range invalid {
+		isInvalid[i] = true
+		invalid[j] = indexes[i]
+	}
+	for j, i := range indexes {
+		if !isInvalid[j] {
+			b.cache.verified.add(b.keys[i])
+		}
+	}
+	return invalid
+}
+
+// bisect returns the invalid signatures in [from, to), verifying the range at once and bisecting it if invalid
+func (b *SignatureBatch) bisect(from, to int) []int {
+	if from >= to || b.verifyRange(from, to) {
+		return nil
+	}
+	if to-from == 1 {
+		return []int{from}
+	}
+	mid := from + (to-from)/2
+	return append(b.bisect(from, mid), b.bisect(mid, to)...)
+}
+
+// verifyRange verifies the signatures in [from, to) at once. The signatures (and public keys) over the same root are first
+// combined with random 64 bit coefficients, so a root signed by a whole committee costs a single pairing check
+func (b *SignatureBatch) verifyRange(from, to int) bool {
+	if to-from == 1 {
+		return b.sigs[from].VerifyByte(&b.pks[from], b.roots[from*32:to*32])
+	}
+
+	coefficients, err := randomCoefficients(to - from)
+	if err != nil {
+		return false
+	}
+	rootIndexes := make(map[[32]byte]int)
+	roots := make([]byte, 0)
+	sigs := make([][]bls.G2, 0)
+	pks := make([][]bls.G1, 0)
+	coeffs := make([][]bls.Fr, 0)
+	for i := from; i < to; i++ {
+		var root [32]byte
+		copy(root[:], b.roots[i*32:(i+1)*32])
+		j, found := rootIndexes[root]
+		if !found {
+			j = len(rootIndexes)
+			rootIndexes[root] = j
+			roots = append(roots, root[:]...)
+			sigs = append(sigs, nil)
+			pks = append(pks, nil)
+			coeffs = append(coeffs, nil)
+		}
+		sigs[j] = append(sigs[j], *bls.CastFromSign(&b.sigs[i]))
+		pks[j] = append(pks[j], *bls.CastFromPublicKey(&b.pks[i]))
+		coeffs[j] = append(coeffs[j], coefficients[i-from])
+	}
+
+	aggSigs := make([]bls.Sign, len(rootIndexes))
+	aggPKs := make([]bls.PublicKey, len(rootIndexes))
+	for j := range aggSigs {
+		bls.G2MulVec(bls.CastFromSign(&aggSigs[j]), sigs[j], coeffs[j])
+		bls.G1MulVec(bls.CastFromPublicKey(&aggPKs[j]), pks[j], coeffs[j])
+	}
+	if len(aggSigs) == 1 {
+		return aggSigs[0].VerifyByte(&aggPKs[0], roots)
+	}
+	return bls.MultiVerify(aggSigs, aggPKs, roots)
+}
+
+// randomCoefficients returns n random 64 bit scalars
+func randomCoefficients(n int) ([]bls.Fr, error) {
+	randomness := make([]byte, 8*n)
+	if _, err := rand.Read(randomness); err != nil {
+		return nil, err
+	}
+	ret := make([]bls.Fr, n)
+	for i := range ret {
+		if err := ret[i].SetLittleEndian(randomness[i*8 : (i+1)*8]); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// VerifyByOperatorsBatch verifies the messages' signatures by the provided operators at once (see SignatureBatch) with the
+// cache of verified signatures (nil for none), returns the error of each message, nil if its signature is valid
+func VerifyByOperatorsBatch(
+	msgs []MessageSignature,
+	domain DomainType,
+	sigType SignatureType,
+	operators []*Operator,
+	cache *BLSSignatureCache,
+) []error {
+	ret := make([]error, len(msgs))
+	batch := NewSignatureBatch().UseCache(cache)
+	batchIndexes := make([]int, 0, len(msgs))
+	for i, msg := range msgs {
+		if err := batch.AddByOperators(msg, domain, sigType, operators); err != nil {
+			ret[i] = err
+			continue
+		}
+		batchIndexes = append(batchIndexes, i)
+	}
+	for _, invalid := range batch.Verify() {
+		ret[batchIndexes[invalid]] = errors.New("failed to verify signature")
+	}
+	return ret
+}
+
+// BLSSignatureCache keeps verified BLS signatures (signature, public keys and root) in an LRU set, so a signature verified
+// ahead of processing (e.g. pending messages' signatures verified at once) isn't verified again while processing.
+// Only valid signatures are cached, so verification results are the same with or without it
+type BLSSignatureCache struct {
+	verified *verifiedSignatures
+}
+
+// NewBLSSignatureCache returns a cache of up to size verified signatures (DefaultVerifiedSignaturesCacheSize if 0)
+func NewBLSSignatureCache(size int) *BLSSignatureCache {
+	if size <= 0 {
+		size = DefaultVerifiedSignaturesCacheSize
+	}
+	return &BLSSignatureCache{verified: newVerifiedSignatures(size)}
+}
+
+// Contains returns true if the signature over the root by the aggregated public keys is cached (nil cache contains none)
+func (c *BLSSignatureCache) Contains(signature Signature, pubKeys [][]byte, root [32]byte) bool {
+	if c == nil {
+		return false
+	}
+	return c.verified.has(blsSignatureKey(signature, pubKeys, root))
+}
+
+// blsSignatureKey returns sha256(signature || public keys || root)
+func blsSignatureKey(signature Signature, pubKeys [][]byte, root [32]byte) [32]byte {
+	h := sha256.New()
+	h.Write(signature)
+	for _, pk := range pubKeys {
+		h.Write(pk)
+	}
+	h.Write(root[:])
+	var ret [32]byte
+	copy(ret[:], h.Sum(nil))
+	return ret
+}
@@ -0,0 +1,227 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// partialSignatures returns each share's signature over the root, in operator ID order
+func partialSignatures(ks *testingutils.TestKeySet, root [32]byte) ([]types.Signature, [][]byte) {
+	sigs := make([]types.Signature, 0)
+	pks := make([][]byte, 0)
+	for id := types.OperatorID(1); id <= types.OperatorID(len(ks.Shares)); id++ {
+		sigs = append(sigs, ks.Shares[id].SignByte(root[:]).Serialize())
+		pks = append(pks, ks.Shares[id].GetPublicKey().Serialize())
+	}
+	return sigs, pks
+}
+
+// prepareMessages returns a prepare message of each of the committee's operators
+func prepareMessages(ks *testingutils.TestKeySet) []types.MessageSignature {
+	ret := make([]types.MessageSignature, 0)
+	for id := types.OperatorID(1); id <= types.OperatorID(len(ks.Shares)); id++ {
+		ret = append(ret, testingutils.TestingPrepareMessage(ks.Shares[id], id))
+	}
+	return ret
+}
+
+func TestSignatureBatch_Verify(t *testing.T) {
+	ks := testingutils.Testing13SharesSet()
+	root := [32]byte{1, 2, 3, 4}
+	otherRoot := [32]byte{5, 6, 7, 8}
+	sigs, pks := partialSignatures(ks, root)
+
+	t.Run("valid", func(t *testing.T) {
+		batch := types.NewSignatureBatch()
+		for i := range sigs {
+			require.NoError(t, batch.Add(sigs[i], [][]byte{pks[i]}, root))
+		}
+		require.Equal(t, len(sigs), batch.Len())
+		require.Empty(t, batch.Verify())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		require.Empty(t, types.NewSignatureBatch().Verify())
+	})
+
+	t.Run("invalid signatures", func(t *testing.T) {
+		batch := types.NewSignatureBatch()
+		for i := range sigs {
+			switch i {
+			case 2, 9:
+				// a valid signature of another operator's key
+				require.NoError(t, batch.Add(sigs[i], [][]byte{pks[i+1]}, root))
+			case 12:
+				// signature over another root
+				require.NoError(t, batch.Add(sigs[i], [][]byte{pks[i]}, otherRoot))
+			default:
+				require.NoError(t, batch.Add(sigs[i], [][]byte{pks[i]}, root))
+			}
+		}
+		require.Equal(t, []int{2, 9, 12}, batch.Verify())
+	})
+
+	t.Run("aggregated public keys", func(t *testing.T) {
+		agg := &bls.Sign{}
+		require.NoError(t, agg.Deserialize(sigs[0]))
+		for _, sig := range sigs[1:3] {
+			s := &bls.Sign{}
+			require.NoError(t, s.Deserialize(sig))
+			agg.Add(s)
+		}
+		batch := types.NewSignatureBatch()
+		require.NoError(t, batch.Add(agg.Serialize(), pks[:3], root))
+		require.NoError(t, batch.Add(sigs[3], [][]byte{pks[3]}, root))
+		require.Empty(t, batch.Verify())
+	})
+
+	t.Run("cache", func(t *testing.T) {
+		cache := types.NewBLSSignatureCache(0)
+		batch := types.NewSignatureBatch().UseCache(cache)
+		for i := range sigs {
+			pk := pks[i]
+			if i == 4 {
+				pk = pks[5]
+			}
+			require.NoError(t, batch.Add(sigs[i], [][]byte{pk}, root))
+		}
+		require.Equal(t, []int{4}, batch.Verify())
+		require.True(t, cache.Contains(sigs[0], [][]byte{pks[0]}, root))
+		require.False(t, cache.Contains(sigs[4], [][]byte{pks[5]}, root))
+		require.False(t, cache.Contains(sigs[0], [][]byte{pks[0]}, otherRoot))
+
+		// cached signatures aren't verified again, invalid ones aren't cached
+		batch = types.NewSignatureBatch().UseCache(cache)
+		require.NoError(t, batch.Add(sigs[4], [][]byte{pks[5]}, root))
+		require.NoError(t, batch.Add(sigs[0], [][]byte{pks[0]}, root))
+		require.NoError(t, batch.Add(sigs[1], [][]byte{pks[1]}, otherRoot))
+		require.Equal(t, []int{0, 2}, batch.Verify())
+	})
+
+	t.Run("invalid encoding", func(t *testing.T) {
+		batch := types.NewSignatureBatch()
+		err := batch.Add(make([]byte, 96), [][]byte{pks[0]}, root)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to deserialize signature")
+		require.Equal(t, 0, batch.Len())
+	})
+}
+
+func TestVerifyByOperatorsBatch(t *testing.T) {
+	ks := testingutils.Testing4SharesSet()
+	committee := testingutils.TestingShare(ks).Committee
+	msgs := prepareMessages(ks)
+
+	// signed by operator 3's share for operator 2
+	msgs[1] = testingutils.TestingPrepareMessage(ks.Shares[3], 2)
+
+	errs := types.VerifyByOperatorsBatch(msgs, testingutils.TestingSSVDomainType, types.QBFTSignatureType, committee, nil)
+	require.NoError(t, errs[0])
+	require.EqualError(t, errs[1], "failed to verify signature")
+	require.NoError(t, errs[2])
+	require.NoError(t, errs[3])
+
+	errs = types.VerifyByOperatorsBatch(msgs, testingutils.TestingSSVDomainType, types.QBFTSignatureType, committee[:3], nil)
+	require.EqualError(t, errs[3], "unknown signer")
+}
+
+// BenchmarkPartialSignatures13 verifies a 13 operators committee's partial signatures one by one
+func BenchmarkPartialSignatures13(b *testing.B) {
+	ks := testingutils.Testing13SharesSet()
+	root := [32]byte{1, 2, 3, 4}
+	sigs, pks := partialSignatures(ks, root)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range sigs {
+			sig := &bls.Sign{}
+			pk := &bls.PublicKey{}
+			if err := sig.Deserialize(sigs[j]); err != nil {
+				b.Fatal(err)
+			}
+			if err := pk.Deserialize(pks[j]); err != nil {
+				b.Fatal(err)
+			}
+			if !sig.VerifyByte(pk, root[:]) {
+				b.Fatal("invalid signature")
+			}
+		}
+	}
+}
+
+// BenchmarkSignatureBatch13 verifies a 13 operators committee's partial signatures at once
+func BenchmarkSignatureBatch13(b *testing.B) {
+	ks := testingutils.Testing13SharesSet()
+	root := [32]byte{1, 2, 3, 4}
+	sigs, pks := partialSignatures(ks, root)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		batch := types.NewSignatureBatch()
+		for j := range sigs {
+			if err := batch.Add(sigs[j], [][]byte{pks[j]}, root); err != nil {
+				b.Fatal(err)
+			}
+		}
+		if invalid := batch.Verify(); len(invalid) > 0 {
+			b.Fatal("invalid signature")
+		}
+	}
+}
+
+// BenchmarkSignatureBatch13OneInvalid verifies a 13 operators committee's partial signatures at once, bisecting to find an invalid one
+func BenchmarkSignatureBatch13OneInvalid(b *testing.B) {
+	ks := testingutils.Testing13SharesSet()
+	root := [32]byte{1, 2, 3, 4}
+	sigs, pks := partialSignatures(ks, root)
+	sigs[5] = sigs[6]
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		batch := types.NewSignatureBatch()
+		for j := range sigs {
+			if err := batch.Add(sigs[j], [][]byte{pks[j]}, root); err != nil {
+				b.Fatal(err)
+			}
+		}
+		if invalid := batch.Verify(); len(invalid) != 1 {
+			b.Fatal("expected an invalid signature")
+		}
+	}
+}
+
+// BenchmarkVerifyByOperators13 verifies a 13 operators committee's qbft messages one by one
+func BenchmarkVerifyByOperators13(b *testing.B) {
+	ks := testingutils.Testing13SharesSet()
+	committee := testingutils.TestingShare(ks).Committee
+	msgs := prepareMessages(ks)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, msg := range msgs {
+			if err := msg.GetSignature().VerifyByOperators(msg, testingutils.TestingSSVDomainType, types.QBFTSignatureType, committee); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkVerifyByOperatorsBatch13 verifies a 13 operators committee's qbft messages at once
+func BenchmarkVerifyByOperatorsBatch13(b *testing.B) {
+	ks := testingutils.Testing13SharesSet()
+	committee := testingutils.TestingShare(ks).Committee
+	msgs := prepareMessages(ks)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, err := range types.VerifyByOperatorsBatch(msgs, testingutils.TestingSSVDomainType, types.QBFTSignatureType, committee, nil) {
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+)
+
+// maxCachedSignerSubsets bounds the number of signer subsets whose Lagrange coefficients a SignatureReconstructor caches
+const maxCachedSignerSubsets = 1024
+
+// SignatureReconstructor reconstructs a committee's threshold signatures from partial signatures (see ReconstructSignatures).
+// The Lagrange coefficients (at 0) of each signers subset are computed once and cached, as a committee's quorums repeat
+// across duties, the signature is then reconstructed with a single multi-scalar multiplication.
+// It's meant to be shared by the runners of a committee, safe for concurrent use
+type SignatureReconstructor struct {
+	mtx          sync.RWMutex
+	coefficients map[string][]bls.Fr
+}
+
+// NewSignatureReconstructor returns a reconstructor with no cached coefficients
+func NewSignatureReconstructor() *SignatureReconstructor {
+	InitBLS()
+	return &SignatureReconstructor{
+		coefficients: make(map[string][]bls.Fr),
+	}
+}
+
+// Reconstruct returns the signature interpolated (at 0) from the signers' partial signatures, each signer's share is
+// evaluated at its operator ID
+func (r *SignatureReconstructor) Reconstruct(signatures map[OperatorID][]byte) (*bls.Sign, error) {
+	if len(signatures) == 0 {
+		return nil, errors.New("no signatures to reconstruct")
+	}
+
+	signers := make([]OperatorID, 0, len(signatures))
+	for signer := range signatures {
+		if signer == 0 {
+			return nil, errors.New("invalid signer 0")
+		}
+		signers = append(signers, signer)
+	}
+	sort.Slice(signers, func(i, j int) bool { return signers[i] < signers[j] })
+
+	sigs := make([]bls.G2, len(signers))
+	for i, signer := range signers {
+		sig := bls.Sign{}
+		if err := sig.Deserialize(signatures[signer]); err != nil {
+			return nil, err
+		}
+		sigs[i] = *bls.CastFromSign(&sig)
+	}
+
+	ret := &bls.Sign{}
+	bls.G2MulVec(bls.CastFromSign(ret), sigs, r.lagrangeCoefficients(signers))
+	return ret, nil
+}
+
+// ReconstructAndVerify returns the reconstructed signature (see Reconstruct), error if it isn't the validator's signature over the root
+func (r *SignatureReconstructor) ReconstructAndVerify(signatures map[OperatorID][]byte, validatorPubKey []byte, root [32]byte) (*bls.Sign, error) {
+	sig, err := r.Reconstruct(signatures)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reconstruct signatures")
+	}
+	if err := VerifyReconstructedSignature(sig, validatorPubKey, root); err != nil {
+		return nil, errors.Wrap(err, "failed to verify reconstruct signature")
+	}
+	return sig, nil
+}
+
+// lagrangeCoefficients returns the Lagrange coefficients at 0 of the (sorted, distinct, non zero) signers, from the cache if computed
+func (r *SignatureReconstructor) lagrangeCoefficients(signers []OperatorID) []bls.Fr {
+	key := make([]byte, 0, 8*len(signers))
+	for _, signer := range signers {
+		key = binary.LittleEndian.AppendUint64(key, signer)
+	}
+
+	r.mtx.RLock()
+	ret, found := r.coefficients[string(key)]
+	r.mtx.RUnlock()
+	if found {
+		return ret
+	}
+
+	ret = computeLagrangeCoefficients(signers)
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if len(r.coefficients) >= maxCachedSignerSubsets {
+		// evict an arbitrary subset
+		for evicted := range r.coefficients {
+			delete(r.coefficients, evicted)
+			break
+		}
+	}
+	r.coefficients[string(key)] = ret
+	return ret
+}
+
+// computeLagrangeCoefficients returns λ_i = Π_{j != i} x_j / (x_j - x_i) for the signers' evaluation points x
+func computeLagrangeCoefficients(signers []OperatorID) []bls.Fr {
+	xs := make([]bls.Fr, len(signers))
+	for i, signer := range signers {
+		_ = xs[i].SetLittleEndian(binary.LittleEndian.AppendUint64(nil, signer))
+	}
+
+	ret := make([]bls.Fr, len(signers))
+	for i := range xs {
+		num := bls.Fr{}
+		den := bls.Fr{}
+		num.SetInt64(1)
+		den.SetInt64(1)
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			diff := bls.Fr{}
+			bls.FrMul(&num, &num, &xs[j])
+			bls.FrSub(&diff, &xs[j], &xs[i])
+			bls.FrMul(&den, &den, &diff)
+		}
+		bls.FrDiv(&ret[i], &num, &den)
+	}
+	return ret
+}
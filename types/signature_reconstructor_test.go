@@ -0,0 +1,162 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// quorumSignatures returns the partial signatures over the root of the first threshold operators, starting at operator from
+func quorumSignatures(ks *testingutils.TestKeySet, root [32]byte, from types.OperatorID) map[types.OperatorID][]byte {
+	ret := make(map[types.OperatorID][]byte)
+	for id := from; uint64(len(ret)) < ks.Threshold; id++ {
+		ret[id] = ks.Shares[id].SignByte(root[:]).Serialize()
+	}
+	return ret
+}
+
+// recoverSignature reconstructs the signature with bls.Sign.Recover, computing the Lagrange coefficients every time
+func recoverSignature(signatures map[types.OperatorID][]byte) (*bls.Sign, error) {
+	ids := make([]bls.ID, 0)
+	sigs := make([]bls.Sign, 0)
+	for signer, signature := range signatures {
+		id := bls.ID{}
+		if err := id.SetDecString(fmt.Sprintf("%d", signer)); err != nil {
+			return nil, err
+		}
+		sig := bls.Sign{}
+		if err := sig.Deserialize(signature); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+		sigs = append(sigs, sig)
+	}
+	ret := &bls.Sign{}
+	return ret, ret.Recover(sigs, ids)
+}
+
+func TestSignatureReconstructor_Reconstruct(t *testing.T) {
+	ks := testingutils.Testing13SharesSet()
+	root := [32]byte{1, 2, 3, 4}
+	expected := ks.ValidatorSK.SignByte(root[:]).Serialize()
+	r := types.NewSignatureReconstructor()
+
+	t.Run("quorums", func(t *testing.T) {
+		for from := types.OperatorID(1); from+types.OperatorID(ks.Threshold)-1 <= types.OperatorID(ks.ShareCount); from++ {
+			signatures := quorumSignatures(ks, root, from)
+			sig, err := r.ReconstructAndVerify(signatures, ks.ValidatorPK.Serialize(), root)
+			require.NoError(t, err)
+			require.Equal(t, expected, sig.Serialize())
+
+			// cached coefficients
+			sig, err = r.Reconstruct(signatures)
+			require.NoError(t, err)
+			require.Equal(t, expected, sig.Serialize())
+
+			recovered, err := recoverSignature(signatures)
+			require.NoError(t, err)
+			require.Equal(t, recovered.Serialize(), sig.Serialize())
+		}
+	})
+
+	t.Run("all signers", func(t *testing.T) {
+		signatures := make(map[types.OperatorID][]byte)
+		for id, share := range ks.Shares {
+			signatures[id] = share.SignByte(root[:]).Serialize()
+		}
+		sig, err := r.Reconstruct(signatures)
+		require.NoError(t, err)
+		require.Equal(t, expected, sig.Serialize())
+	})
+
+	t.Run("not enough signers", func(t *testing.T) {
+		signatures := quorumSignatures(ks, root, 1)
+		delete(signatures, 1)
+		_, err := r.ReconstructAndVerify(signatures, ks.ValidatorPK.Serialize(), root)
+		require.EqualError(t, err, "failed to verify reconstruct signature: could not reconstruct a valid signature")
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		signatures := quorumSignatures(ks, root, 1)
+		signatures[2] = signatures[3]
+		_, err := r.ReconstructAndVerify(signatures, ks.ValidatorPK.Serialize(), root)
+		require.EqualError(t, err, "failed to verify reconstruct signature: could not reconstruct a valid signature")
+	})
+
+	t.Run("no signatures", func(t *testing.T) {
+		_, err := r.Reconstruct(map[types.OperatorID][]byte{})
+		require.EqualError(t, err, "no signatures to reconstruct")
+	})
+
+	t.Run("zero signer", func(t *testing.T) {
+		signatures := quorumSignatures(ks, root, 1)
+		signatures[0] = signatures[1]
+		_, err := r.Reconstruct(signatures)
+		require.EqualError(t, err, "invalid signer 0")
+	})
+}
+
+func benchmarkRecoverSignature(b *testing.B, ks *testingutils.TestKeySet) {
+	root := [32]byte{1, 2, 3, 4}
+	signatures := quorumSignatures(ks, root, 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sig, err := recoverSignature(signatures)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := types.VerifyReconstructedSignature(sig, ks.ValidatorPK.Serialize(), root); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkSignatureReconstructor(b *testing.B, ks *testingutils.TestKeySet) {
+	root := [32]byte{1, 2, 3, 4}
+	signatures := quorumSignatures(ks, root, 1)
+	r := types.NewSignatureReconstructor()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := r.ReconstructAndVerify(signatures, ks.ValidatorPK.Serialize(), root); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRecoverSignature4(b *testing.B) {
+	benchmarkRecoverSignature(b, testingutils.Testing4SharesSet())
+}
+
+func BenchmarkRecoverSignature7(b *testing.B) {
+	benchmarkRecoverSignature(b, testingutils.Testing7SharesSet())
+}
+
+func BenchmarkRecoverSignature10(b *testing.B) {
+	benchmarkRecoverSignature(b, testingutils.Testing10SharesSet())
+}
+
+func BenchmarkRecoverSignature13(b *testing.B) {
+	benchmarkRecoverSignature(b, testingutils.Testing13SharesSet())
+}
+
+func BenchmarkSignatureReconstructor4(b *testing.B) {
+	benchmarkSignatureReconstructor(b, testingutils.Testing4SharesSet())
+}
+
+func BenchmarkSignatureReconstructor7(b *testing.B) {
+	benchmarkSignatureReconstructor(b, testingutils.Testing7SharesSet())
+}
+
+func BenchmarkSignatureReconstructor10(b *testing.B) {
+	benchmarkSignatureReconstructor(b, testingutils.Testing10SharesSet())
+}
+
+func BenchmarkSignatureReconstructor13(b *testing.B) {
+	benchmarkSignatureReconstructor(b, testingutils.Testing13SharesSet())
+}
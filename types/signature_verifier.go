@@ -1,7 +1,252 @@
 package types
 
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"runtime"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
 // SignatureVerifier is an interface responsible for the verification of SignedSSVMessages
 type SignatureVerifier interface {
 	// Verify verifies a SignedSSVMessage's signature using the necessary keys extracted from the list of Operators
 	Verify(msg *SignedSSVMessage, operators []*Operator) error
 }
+
+// BatchSignatureVerifier is a SignatureVerifier verifying many SignedSSVMessages at once
+type BatchSignatureVerifier interface {
+	SignatureVerifier
+	// VerifyMany verifies the SignedSSVMessages' signatures, each with the keys of its committee (committees[i] for msgs[i]).
+	// Returns the error of each message, nil if its signature is valid
+	VerifyMany(msgs []*SignedSSVMessage, committees [][]*Operator) []error
+}
+
+const (
+	// DefaultVerifiedSignaturesCacheSize is the default number of verified signatures kept by OperatorSignatureVerifier
+	DefaultVerifiedSignaturesCacheSize = 65536
+)
+
+// OperatorSignatureVerifier verifies SignedSSVMessages with the operators' SSV operator keys.
+// Parsed keys are cached per OperatorID (re-parsed if the operator's key changes), signatures are verified by a bounded
+// number of workers and valid (operator key, message, signature) triplets are kept in an LRU cache so a message
+// received more than once is verified once.
+// With an epoch clock, the operators' keys valid at the current epoch are accepted (both keys during a key rotation's overlap
+// window, see Operator.SSVOperatorKeysAtEpoch), otherwise only their current keys
+type OperatorSignatureVerifier struct {
+	workers      chan struct{}
+	currentEpoch func() phase0.Epoch
+
+	keysMtx sync.RWMutex
+	keys    map[operatorKeyID]*cachedOperatorKey
+
+	verified *verifiedSignatures
+}
+
+// operatorKeyID identifies an operator's current or next SSV operator key
+type operatorKeyID struct {
+	operatorID OperatorID
+	next       bool
+}
+
+// cachedOperatorKey is a parsed SSV operator key, fingerprint is sha256 of its key type and public key
+type cachedOperatorKey struct {
+	keyType     OperatorKeyType
+	pubKey      []byte
+	parsed      OperatorPubKey
+	fingerprint [32]byte
+}
+
+// NewOperatorSignatureVerifier returns a verifier verifying with up to workers signatures in parallel (runtime.NumCPU() if 0),
+// caching up to cacheSize verified signatures (DefaultVerifiedSignaturesCacheSize if 0).
+// currentEpoch returns the epoch messages are verified at, if nil only the operators' current keys are accepted
+func NewOperatorSignatureVerifier(workers int, cacheSize int, currentEpoch func() phase0.Epoch) *OperatorSignatureVerifier {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if cacheSize <= 0 {
+		cacheSize = DefaultVerifiedSignaturesCacheSize
+	}
+	return &OperatorSignatureVerifier{
+		workers:      make(chan struct{}, workers),
+		currentEpoch: currentEpoch,
+		keys:         make(map[operatorKeyID]*cachedOperatorKey),
+		verified:     newVerifiedSignatures(cacheSize),
+	}
+}
+
+// Verify verifies the SignedSSVMessage's signature with its signer's SSV operator keys valid at the current epoch.
+// Returns the first key's error if none of them verifies it
+func (v *OperatorSignatureVerifier) Verify(msg *SignedSSVMessage, operators []*Operator) error {
+	operator := findOperator(msg.GetOperatorID(), operators)
+	if operator == nil {
+		return errors.New("unknown signer")
+	}
+
+	var firstErr error
+	for _, operatorKey := range v.operatorKeys(operator) {
+		err := v.verifyWithKey(msg, operator.OperatorID, operatorKey)
+		if err == nil {
+			return nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr == nil {
+		return errors.New("no operator key valid at epoch")
+	}
+	return firstErr
+}
+
+// operatorKeys returns the operator's SSV operator keys accepted by the verifier
+func (v *OperatorSignatureVerifier) operatorKeys(operator *Operator) []*OperatorKey {
+	if v.currentEpoch == nil {
+		return []*OperatorKey{{KeyType: operator.SSVOperatorKeyType, PubKey: operator.SSVOperatorPubKey}}
+	}
+	return operator.SSVOperatorKeysAtEpoch(v.currentEpoch())
+}
+
+func (v *OperatorSignatureVerifier) verifyWithKey(msg *SignedSSVMessage, operatorID OperatorID, operatorKey *OperatorKey) error {
+	key, err := v.operatorKey(operatorID, operatorKey)
+	if err != nil {
+		return err
+	}
+
+	cacheKey := verifiedSignatureKey(key, msg)
+	if v.verified.has(cacheKey) {
+		return nil
+	}
+	if err := key.parsed.Verify(msg.Data, msg.Signature); err != nil {
+		return err
+	}
+	v.verified.add(cacheKey)
+	return nil
+}
+
+// VerifyMany verifies the SignedSSVMessages in parallel, by up to the verifier's number of workers (shared with
+// concurrent VerifyMany calls)
+func (v *OperatorSignatureVerifier) VerifyMany(msgs []*SignedSSVMessage, committees [][]*Operator) []error {
+	ret := make([]error, len(msgs))
+	if len(committees) != len(msgs) {
+		for i := range ret {
+			ret[i] = errors.New("committees don't match messages")
+		}
+		return ret
+	}
+
+	var wg sync.WaitGroup
+	for i := range msgs {
+		v.workers <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-v.workers
+				wg.Done()
+			}()
+			ret[i] = v.Verify(msgs[i], committees[i])
+		}(i)
+	}
+	wg.Wait()
+	return ret
+}
+
+// operatorKey returns the operator's parsed SSV operator key, parsing and caching it if not cached or if the operator's key changed
+func (v *OperatorSignatureVerifier) operatorKey(operatorID OperatorID, operatorKey *OperatorKey) (*cachedOperatorKey, error) {
+	id := operatorKeyID{operatorID: operatorID, next: operatorKey.Next}
+	v.keysMtx.RLock()
+	key, found := v.keys[id]
+	v.keysMtx.RUnlock()
+	if found && key.keyType == operatorKey.KeyType && bytes.Equal(key.pubKey, operatorKey.PubKey) {
+		return key, nil
+	}
+
+	parsed, err := ParseOperatorPubKey(operatorKey.KeyType, operatorKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	key = &cachedOperatorKey{
+		keyType: operatorKey.KeyType,
+		pubKey:  bytes.Clone(operatorKey.PubKey),
+		parsed:  parsed,
+	}
+	h := sha256.New()
+	h.Write(binary.LittleEndian.AppendUint64(nil, uint64(key.keyType)))
+	h.Write(key.pubKey)
+	copy(key.fingerprint[:], h.Sum(nil))
+
+	v.keysMtx.Lock()
+	v.keys[id] = key
+	v.keysMtx.Unlock()
+	return key, nil
+}
+
+func findOperator(operatorID OperatorID, operators []*Operator) *Operator {
+	for _, operator := range operators {
+		if operator.OperatorID == operatorID {
+			return operator
+		}
+	}
+	return nil
+}
+
+// verifiedSignatureKey returns sha256(key fingerprint || operator ID || signature size || signature || data)
+func verifiedSignatureKey(key *cachedOperatorKey, msg *SignedSSVMessage) [32]byte {
+	h := sha256.New()
+	h.Write(key.fingerprint[:])
+	h.Write(binary.LittleEndian.AppendUint64(nil, uint64(msg.OperatorID)))
+	h.Write(binary.LittleEndian.AppendUint64(nil, uint64(len(msg.Signature))))
+	h.Write(msg.Signature)
+	h.Write(msg.Data)
+	var ret [32]byte
+	copy(ret[:], h.Sum(nil))
+	return ret
+}
+
+// verifiedSignatures is an LRU set of verified signature keys (see verifiedSignatureKey).
+// Only valid signatures are added, invalid ones can't evict them
+type verifiedSignatures struct {
+	mtx     sync.Mutex
+	size    int
+	order   *list.List
+	entries map[[32]byte]*list.Element
+}
+
+func newVerifiedSignatures(size int) *verifiedSignatures {
+	return &verifiedSignatures{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[[32]byte]*list.Element),
+	}
+}
+
+func (c *verifiedSignatures) has(key [32]byte) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	e, found := c.entries[key]
+	if found {
+		c.order.MoveToFront(e)
+	}
+	return found
+}
+
+func (c *verifiedSignatures) add(key [32]byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, found := c.entries[key]; found {
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.order.PushFront(key)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.([32]byte))
+	}
+}
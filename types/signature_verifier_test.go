@@ -0,0 +1,182 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// committeeMessages returns a SignedSSVMessage of each of the committee's operators
+func committeeMessages(ks *testingutils.TestKeySet) []*types.SignedSSVMessage {
+	ret := make([]*types.SignedSSVMessage, 0)
+	for id := types.OperatorID(1); id <= types.OperatorID(len(ks.Shares)); id++ {
+		ret = append(ret, testingutils.SignedSSVMessageF(ks,
+			testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[id], id, testingutils.TestingDutySlot))))
+	}
+	return ret
+}
+
+func sameCommittee(committee []*types.Operator, n int) [][]*types.Operator {
+	ret := make([][]*types.Operator, n)
+	for i := range ret {
+		ret[i] = committee
+	}
+	return ret
+}
+
+func TestOperatorSignatureVerifier_Verify(t *testing.T) {
+	ks := testingutils.Testing4SharesSet()
+	committee := testingutils.TestingShare(ks).Committee
+	msg := committeeMessages(ks)[0]
+
+	t.Run("valid", func(t *testing.T) {
+		v := types.NewOperatorSignatureVerifier(0, 0, nil)
+		require.NoError(t, v.Verify(msg, committee))
+		// cached
+		require.NoError(t, v.Verify(msg, committee))
+	})
+
+	t.Run("unknown signer", func(t *testing.T) {
+		v := types.NewOperatorSignatureVerifier(0, 0, nil)
+		require.EqualError(t, v.Verify(msg, committee[1:]), "unknown signer")
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		v := types.NewOperatorSignatureVerifier(0, 0, nil)
+		invalid := *msg
+		invalid.Signature = testingutils.TestingSignedSSVMessageSignature
+		require.EqualError(t, v.Verify(&invalid, committee), "crypto/rsa: verification error")
+	})
+
+	t.Run("changed operator key", func(t *testing.T) {
+		v := types.NewOperatorSignatureVerifier(0, 0, nil)
+		require.NoError(t, v.Verify(msg, committee))
+
+		// the cached key and verified signature don't apply to the operator's new key
+		changed := make([]*types.Operator, len(committee))
+		copy(changed, committee)
+		changed[0] = testingutils.TestingOperatorWithKeyType(committee[0], types.Ed25519OperatorKey)
+		require.EqualError(t, v.Verify(msg, changed), "invalid ed25519 signature size")
+	})
+}
+
+func TestOperatorSignatureVerifier_KeyRotation(t *testing.T) {
+	ks := testingutils.Testing4SharesSet()
+	committee := testingutils.TestingShare(ks).Committee
+	committee[0] = testingutils.TestingRotatingOperator(committee[0], types.Ed25519OperatorKey, 10)
+	msg := committeeMessages(ks)[0]
+
+	epoch := phase0.Epoch(9)
+	v := types.NewOperatorSignatureVerifier(0, 0, func() phase0.Epoch { return epoch })
+	require.NoError(t, v.Verify(msg, committee))
+
+	// the current key's cached verified signature doesn't apply once the key expires
+	epoch = 11
+	require.EqualError(t, v.Verify(msg, committee), "invalid ed25519 signature size")
+
+	// without an epoch clock only the current key is accepted
+	next := testingutils.SignedSSVMessageWithKeyType(ks, 1, types.Ed25519OperatorKey,
+		testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, testingutils.TestingDutySlot)))
+	require.NoError(t, v.Verify(next, committee))
+	require.EqualError(t, types.NewOperatorSignatureVerifier(0, 0, nil).Verify(next, committee), "invalid rsa signature size")
+}
+
+func TestOperatorSignatureVerifier_VerifyMany(t *testing.T) {
+	ks := testingutils.Testing13SharesSet()
+	committee := testingutils.TestingShare(ks).Committee
+	msgs := committeeMessages(ks)
+
+	invalid := *msgs[3]
+	invalid.Signature = testingutils.TestingSignedSSVMessageSignature
+	msgs[3] = &invalid
+
+	v := types.NewOperatorSignatureVerifier(2, 0, nil)
+	errs := v.VerifyMany(msgs, sameCommittee(committee, len(msgs)))
+	require.Len(t, errs, len(msgs))
+	for i, err := range errs {
+		if i == 3 {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err, "message %d", i)
+		}
+	}
+
+	errs = v.VerifyMany(msgs, sameCommittee(committee, 1))
+	require.EqualError(t, errs[0], "committees don't match messages")
+}
+
+// BenchmarkVerifyOperatorSignature13 verifies a 13 operators committee's messages, parsing the operator key for each
+func BenchmarkVerifyOperatorSignature13(b *testing.B) {
+	ks := testingutils.Testing13SharesSet()
+	committee := testingutils.TestingShare(ks).Committee
+	msgs := committeeMessages(ks)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, msg := range msgs {
+			if err := types.VerifyOperatorSignature(committee[j], msg.Data, msg.Signature); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkOperatorSignatureVerifier_Verify13 verifies a 13 operators committee's messages one by one with cached keys,
+// the verified signatures cache holds a single signature so every verification misses it
+func BenchmarkOperatorSignatureVerifier_Verify13(b *testing.B) {
+	ks := testingutils.Testing13SharesSet()
+	committee := testingutils.TestingShare(ks).Committee
+	msgs := committeeMessages(ks)
+	v := types.NewOperatorSignatureVerifier(0, 1, nil)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, msg := range msgs {
+			if err := v.Verify(msg, committee); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkOperatorSignatureVerifier_VerifyMany13 verifies a 13 operators committee's messages at once with cached keys,
+// the verified signatures cache holds a single signature so every verification misses it
+func BenchmarkOperatorSignatureVerifier_VerifyMany13(b *testing.B) {
+	ks := testingutils.Testing13SharesSet()
+	committee := testingutils.TestingShare(ks).Committee
+	msgs := committeeMessages(ks)
+	committees := sameCommittee(committee, len(msgs))
+	v := types.NewOperatorSignatureVerifier(0, 1, nil)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, err := range v.VerifyMany(msgs, committees) {
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkOperatorSignatureVerifier_VerifyManyCached13 verifies a 13 operators committee's messages already verified,
+// as when the same messages are received from several peers
+func BenchmarkOperatorSignatureVerifier_VerifyManyCached13(b *testing.B) {
+	ks := testingutils.Testing13SharesSet()
+	committee := testingutils.TestingShare(ks).Committee
+	msgs := committeeMessages(ks)
+	committees := sameCommittee(committee, len(msgs))
+	v := types.NewOperatorSignatureVerifier(0, 0, nil)
+	v.VerifyMany(msgs, committees)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, err := range v.VerifyMany(msgs, committees) {
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -12,7 +12,7 @@ import (
 type SignatureDomain []byte
 type Signature []byte
 type SignatureType [4]byte
-type SignSSVMessageF = func(data []byte) ([256]byte, error)
+type SignSSVMessageF = func(data []byte) ([]byte, error)
 
 func (sigType SignatureType) Equal(other SignatureType) bool {
 	return bytes.Equal(sigType[:], other[:])
@@ -33,9 +33,9 @@ type BeaconSigner interface {
 	IsBeaconBlockSlashable(pk []byte, slot spec.Slot) error
 }
 
-// OperatorSigner used for to sign protocol messages
+// OperatorSigner used for to sign protocol messages, the signature's size depends on the operator's key type (see OperatorKeyType)
 type OperatorSigner interface {
-	SignSSVMessage(data []byte) ([256]byte, error)
+	SignSSVMessage(data []byte) ([]byte, error)
 }
 
 // ShareSigner used for signing with the operator's share
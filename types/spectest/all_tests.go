@@ -7,6 +7,8 @@ import (
 	"github.com/ssvlabs/ssv-spec/types/spectest/tests/consensusdata"
 	consensusdataproposer "github.com/ssvlabs/ssv-spec/types/spectest/tests/consensusdata/proposer"
 	"github.com/ssvlabs/ssv-spec/types/spectest/tests/encryption"
+	"github.com/ssvlabs/ssv-spec/types/spectest/tests/envelope"
+	"github.com/ssvlabs/ssv-spec/types/spectest/tests/keystore"
 	"github.com/ssvlabs/ssv-spec/types/spectest/tests/partialsigmessage"
 	"github.com/ssvlabs/ssv-spec/types/spectest/tests/share"
 	"github.com/ssvlabs/ssv-spec/types/spectest/tests/ssvmsg"
@@ -51,6 +53,18 @@ var AllTests = []SpecTest{
 	encryption.SimpleEncrypt(),
 	encryption.EncryptBLSSK(),
 
+	envelope.EncryptShareSecret(),
+	envelope.NoValidatorPubKey(),
+	envelope.LegacyPKCS1v15(),
+	envelope.LegacyPKCS1v15Migration(),
+	envelope.LegacyMigrationEnvelope(),
+	envelope.WrongOperatorID(),
+	envelope.WrongValidatorPubKey(),
+	envelope.WrongKey(),
+	envelope.TamperedCipherText(),
+	envelope.UnknownVersion(),
+	envelope.TruncatedEnvelope(),
+
 	consensusdata.InvalidDuty(),
 
 	consensusdata.ProposerConsensusDataEncoding(),
@@ -105,6 +119,19 @@ var AllTests = []SpecTest{
 	signedssvmsg.EmptySignature(),
 	signedssvmsg.ZeroSigner(),
 	signedssvmsg.WrongData(),
+	signedssvmsg.Ed25519Key(),
+	signedssvmsg.Secp256k1Key(),
+	signedssvmsg.MixedKeysCommittee(),
+	signedssvmsg.WrongKeyType(),
+	signedssvmsg.WrongSignatureSize(),
+	signedssvmsg.Secp256k1HighS(),
+	signedssvmsg.KeyRotationBeforeActivation(),
+	signedssvmsg.KeyRotationCurrentKeyInOverlap(),
+	signedssvmsg.KeyRotationCurrentKeyExpired(),
+	signedssvmsg.KeyRotationNextKeyAtActivation(),
+	signedssvmsg.KeyRotationNextKeyInOverlap(),
+	signedssvmsg.KeyRotationNextKeyTooEarly(),
+	signedssvmsg.KeyRotationWrongKeyInOverlap(),
 
 	share.HasPartialQuorumButNoQuorum(),
 	share.HasQuorum(),
@@ -114,4 +141,41 @@ var AllTests = []SpecTest{
 	share.NoQuorumDuplicate(),
 	share.PartialQuorumWithDuplicate(),
 	share.QuorumWithDuplicate(),
+	share.WeightedQuorumEqualWeights(),
+	share.WeightedQuorumHeavySigner(),
+	share.WeightedQuorumLightSigners(),
+	share.WeightedQuorumHeavyNoPartialQuorum(),
+	share.WeightedQuorumDuplicateSigner(),
+	share.WeightedQuorumUnknownSigner(),
+	share.WeightedQuorumSevenOperators(),
+	share.ValidationValid4Operators(),
+	share.ValidationValid13Operators(),
+	share.ValidationValidWeighted(),
+	share.ValidationOperatorWeightAboveF(),
+	share.ValidationInvalidCommitteeSize(),
+	share.ValidationUnsortedCommittee(),
+	share.ValidationDuplicateOperator(),
+	share.ValidationOperatorNotInCommittee(),
+	share.ValidationSharePubKeyMismatch(),
+	share.ValidationWrongQuorum(),
+	share.ValidationWrongValidatorPubKey(),
+	share.ValidationWrongOperatorSharePubKey(),
+	share.ValidationInvalidSharePubKey(),
+	share.ValidationThresholdAboveMinQuorum(),
+	share.ValidationRotatingOperator(),
+	share.ValidationNextKeyNotAfterCurrentKey(),
+
+	keystore.ScryptTestVector(),
+	keystore.PBKDF2TestVector(),
+	keystore.NormalizedPassword(),
+	keystore.PasswordControlCodes(),
+	keystore.WrongPassword(),
+	keystore.TamperedCipherMessage(),
+	keystore.PubKeyMismatch(),
+	keystore.UnknownKDF(),
+	keystore.UnknownCipher(),
+	keystore.LoadKeystores(),
+	keystore.LoadEmptyDirectory(),
+	keystore.LoadWrongPassword(),
+	keystore.LoadPubKeyMismatch(),
 }
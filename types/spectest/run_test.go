@@ -15,6 +15,8 @@ import (
 	"github.com/ssvlabs/ssv-spec/types/spectest/tests/consensusdata"
 	consensusdataproposer "github.com/ssvlabs/ssv-spec/types/spectest/tests/consensusdata/proposer"
 	"github.com/ssvlabs/ssv-spec/types/spectest/tests/encryption"
+	"github.com/ssvlabs/ssv-spec/types/spectest/tests/envelope"
+	"github.com/ssvlabs/ssv-spec/types/spectest/tests/keystore"
 	"github.com/ssvlabs/ssv-spec/types/spectest/tests/partialsigmessage"
 	"github.com/ssvlabs/ssv-spec/types/spectest/tests/share"
 	"github.com/ssvlabs/ssv-spec/types/spectest/tests/signedssvmsg"
@@ -91,6 +93,24 @@ func TestJson(t *testing.T) {
 				typedTest := &encryption.EncryptionSpecTest{}
 				require.NoError(t, json.Unmarshal(byts, &typedTest))
 				typedTest.Run(t)
+			case reflect.TypeOf(&envelope.EnvelopeSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &envelope.EnvelopeSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&keystore.KeystoreSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &keystore.KeystoreSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&keystore.KeystoreLoadSpecTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &keystore.KeystoreLoadSpecTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
 			case reflect.TypeOf(&beacon.DepositDataSpecTest{}).String():
 				byts, err := json.Marshal(test)
 				require.NoError(t, err)
@@ -109,6 +129,18 @@ func TestJson(t *testing.T) {
 				typedTest := &signedssvmsg.SignedSSVMessageTest{}
 				require.NoError(t, json.Unmarshal(byts, &typedTest))
 				typedTest.Run(t)
+			case reflect.TypeOf(&signedssvmsg.OperatorKeyTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &signedssvmsg.OperatorKeyTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&signedssvmsg.KeyRotationTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &signedssvmsg.KeyRotationTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
 			case reflect.TypeOf(&consensusdata.ConsensusDataTest{}).String():
 				byts, err := json.Marshal(test)
 				require.NoError(t, err)
@@ -127,6 +159,18 @@ func TestJson(t *testing.T) {
 				typedTest := &share.ShareTest{}
 				require.NoError(t, json.Unmarshal(byts, &typedTest))
 				typedTest.Run(t)
+			case reflect.TypeOf(&share.WeightedQuorumTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &share.WeightedQuorumTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
+			case reflect.TypeOf(&share.ShareValidationTest{}).String():
+				byts, err := json.Marshal(test)
+				require.NoError(t, err)
+				typedTest := &share.ShareValidationTest{}
+				require.NoError(t, json.Unmarshal(byts, &typedTest))
+				typedTest.Run(t)
 			case reflect.TypeOf(&ssvmsg.SSVMessageTest{}).String():
 				byts, err := json.Marshal(test)
 				require.NoError(t, err)
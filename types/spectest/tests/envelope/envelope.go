@@ -0,0 +1,177 @@
+package envelope
+
+import (
+	"crypto/rsa"
+
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// EncryptShareSecret tests an envelope encrypting a share secret
+func EncryptShareSecret() *EnvelopeSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &EnvelopeSpecTest{
+		Name:            "encrypt share secret",
+		SKPem:           types.PrivateKeyToPem(encryptionKey(ks, 1)),
+		OperatorID:      1,
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		CipherText:      encryptShare(encryptionKey(ks, 1), 1, ks.ValidatorPK.Serialize(), ks.Shares[1].Serialize()),
+		PlainText:       ks.Shares[1].Serialize(),
+	}
+}
+
+// NoValidatorPubKey tests an envelope bound only to the operator ID, a validator public key isn't known before a DKG ceremony
+func NoValidatorPubKey() *EnvelopeSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &EnvelopeSpecTest{
+		Name:       "no validator public key",
+		SKPem:      types.PrivateKeyToPem(encryptionKey(ks, 2)),
+		OperatorID: 2,
+		CipherText: encryptShare(encryptionKey(ks, 2), 2, nil, ks.Shares[2].Serialize()),
+		PlainText:  ks.Shares[2].Serialize(),
+	}
+}
+
+// LegacyPKCS1v15 tests a legacy PKCS1v15 payload is rejected, only DecryptLegacyShare decrypts it
+func LegacyPKCS1v15() *EnvelopeSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &EnvelopeSpecTest{
+		Name:            "legacy pkcs1v15",
+		SKPem:           types.PrivateKeyToPem(encryptionKey(ks, 1)),
+		OperatorID:      1,
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		CipherText:      legacyEncrypt(encryptionKey(ks, 1), ks.Shares[1].Serialize()),
+		ExpectedError:   "Failed to decrypt, invalid envelope length",
+	}
+}
+
+// LegacyPKCS1v15Migration tests migrating a legacy PKCS1v15 payload with DecryptLegacyShare, not bound to the operator ID and validator public key
+func LegacyPKCS1v15Migration() *EnvelopeSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &EnvelopeSpecTest{
+		Name:            "legacy pkcs1v15 migration",
+		Legacy:          true,
+		SKPem:           types.PrivateKeyToPem(encryptionKey(ks, 1)),
+		OperatorID:      1,
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		CipherText:      legacyEncrypt(encryptionKey(ks, 1), ks.Shares[1].Serialize()),
+		PlainText:       ks.Shares[1].Serialize(),
+	}
+}
+
+// LegacyMigrationEnvelope tests an envelope isn't decrypted by DecryptLegacyShare
+func LegacyMigrationEnvelope() *EnvelopeSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &EnvelopeSpecTest{
+		Name:            "legacy migration envelope",
+		Legacy:          true,
+		SKPem:           types.PrivateKeyToPem(encryptionKey(ks, 1)),
+		OperatorID:      1,
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		CipherText:      encryptShare(encryptionKey(ks, 1), 1, ks.ValidatorPK.Serialize(), ks.Shares[1].Serialize()),
+		ExpectedError:   "Failed to decrypt, invalid legacy payload length",
+	}
+}
+
+// WrongOperatorID tests an envelope decrypted for another operator ID
+func WrongOperatorID() *EnvelopeSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &EnvelopeSpecTest{
+		Name:            "wrong operator id",
+		SKPem:           types.PrivateKeyToPem(encryptionKey(ks, 1)),
+		OperatorID:      2,
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		CipherText:      encryptShare(encryptionKey(ks, 1), 1, ks.ValidatorPK.Serialize(), ks.Shares[1].Serialize()),
+		ExpectedError:   "Failed to decrypt envelope: cipher: message authentication failed",
+	}
+}
+
+// WrongValidatorPubKey tests an envelope decrypted for another validator public key
+func WrongValidatorPubKey() *EnvelopeSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &EnvelopeSpecTest{
+		Name:            "wrong validator public key",
+		SKPem:           types.PrivateKeyToPem(encryptionKey(ks, 1)),
+		OperatorID:      1,
+		ValidatorPubKey: ks.Shares[1].GetPublicKey().Serialize(),
+		CipherText:      encryptShare(encryptionKey(ks, 1), 1, ks.ValidatorPK.Serialize(), ks.Shares[1].Serialize()),
+		ExpectedError:   "Failed to decrypt envelope: cipher: message authentication failed",
+	}
+}
+
+// WrongKey tests an envelope encrypted to another operator's key
+func WrongKey() *EnvelopeSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	return &EnvelopeSpecTest{
+		Name:            "wrong key",
+		SKPem:           types.PrivateKeyToPem(encryptionKey(ks, 1)),
+		OperatorID:      1,
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		CipherText:      encryptShare(encryptionKey(ks, 2), 1, ks.ValidatorPK.Serialize(), ks.Shares[1].Serialize()),
+		ExpectedError:   "Failed to unwrap key: crypto/rsa: decryption error",
+	}
+}
+
+// TamperedCipherText tests an envelope whose AES-GCM cipher text was tampered with
+func TamperedCipherText() *EnvelopeSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	cipher := encryptShare(encryptionKey(ks, 1), 1, ks.ValidatorPK.Serialize(), ks.Shares[1].Serialize())
+	cipher[len(cipher)-1] ^= 1
+	return &EnvelopeSpecTest{
+		Name:            "tampered cipher text",
+		SKPem:           types.PrivateKeyToPem(encryptionKey(ks, 1)),
+		OperatorID:      1,
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		CipherText:      cipher,
+		ExpectedError:   "Failed to decrypt envelope: cipher: message authentication failed",
+	}
+}
+
+// UnknownVersion tests an envelope of an unknown version
+func UnknownVersion() *EnvelopeSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	cipher := encryptShare(encryptionKey(ks, 1), 1, ks.ValidatorPK.Serialize(), ks.Shares[1].Serialize())
+	cipher[0] = 2
+	return &EnvelopeSpecTest{
+		Name:            "unknown version",
+		SKPem:           types.PrivateKeyToPem(encryptionKey(ks, 1)),
+		OperatorID:      1,
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		CipherText:      cipher,
+		ExpectedError:   "Failed to decrypt, unknown envelope version",
+	}
+}
+
+// TruncatedEnvelope tests an envelope shorter than the wrapped key, nonce and tag
+func TruncatedEnvelope() *EnvelopeSpecTest {
+	ks := testingutils.Testing4SharesSet()
+	cipher := encryptShare(encryptionKey(ks, 1), 1, ks.ValidatorPK.Serialize(), ks.Shares[1].Serialize())
+	return &EnvelopeSpecTest{
+		Name:            "truncated envelope",
+		SKPem:           types.PrivateKeyToPem(encryptionKey(ks, 1)),
+		OperatorID:      1,
+		ValidatorPubKey: ks.ValidatorPK.Serialize(),
+		CipherText:      cipher[:100],
+		ExpectedError:   "Failed to decrypt, invalid envelope length",
+	}
+}
+
+func encryptionKey(ks *testingutils.TestKeySet, operatorID types.OperatorID) *rsa.PrivateKey {
+	return ks.DKGOperators[operatorID].EncryptionKey
+}
+
+func encryptShare(sk *rsa.PrivateKey, operatorID types.OperatorID, validatorPK []byte, plainText []byte) []byte {
+	ret, err := types.EncryptShare(&sk.PublicKey, operatorID, validatorPK, plainText)
+	if err != nil {
+		panic(err.Error())
+	}
+	return ret
+}
+
+func legacyEncrypt(sk *rsa.PrivateKey, plainText []byte) []byte {
+	ret, err := types.Encrypt(&sk.PublicKey, plainText)
+	if err != nil {
+		panic(err.Error())
+	}
+	return ret
+}
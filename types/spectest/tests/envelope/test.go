@@ -0,0 +1,52 @@
+package envelope
+
+import (
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+// EnvelopeSpecTest tests decrypting a share secret's cipher text (an EncryptShare envelope or a legacy PKCS1v15 payload)
+// for the operator ID and validator public key
+type EnvelopeSpecTest struct {
+	Name string
+	// Legacy decrypts the cipher text with DecryptLegacyShare (migration) instead of DecryptShare
+	Legacy          bool `json:",omitempty"`
+	SKPem           []byte
+	OperatorID      types.OperatorID
+	ValidatorPubKey []byte
+	CipherText      []byte
+	PlainText       []byte
+	ExpectedError   string
+}
+
+func (test *EnvelopeSpecTest) TestName() string {
+	return "envelope " + test.Name
+}
+
+func (test *EnvelopeSpecTest) Run(t *testing.T) {
+	sk, err := types.PemToPrivateKey(test.SKPem)
+	require.NoError(t, err)
+
+	var plain []byte
+	if test.Legacy {
+		plain, err = types.DecryptLegacyShare(sk, test.CipherText)
+	} else {
+		plain, err = types.DecryptShare(sk, test.OperatorID, test.ValidatorPubKey, test.CipherText)
+	}
+	if len(test.ExpectedError) != 0 {
+		require.EqualError(t, err, test.ExpectedError)
+		return
+	}
+	require.NoError(t, err)
+	require.EqualValues(t, test.PlainText, plain)
+
+	// encrypt and decrypt the plain text
+	cipher, err := types.EncryptShare(&sk.PublicKey, test.OperatorID, test.ValidatorPubKey, test.PlainText)
+	require.NoError(t, err)
+	require.EqualValues(t, types.EncryptionEnvelopeV1, cipher[0])
+	plain, err = types.DecryptShare(sk, test.OperatorID, test.ValidatorPubKey, cipher)
+	require.NoError(t, err)
+	require.EqualValues(t, test.PlainText, plain)
+}
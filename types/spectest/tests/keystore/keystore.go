@@ -0,0 +1,198 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+// EIP-2335 test vectors (https://eips.ethereum.org/EIPS/eip-2335#test-cases)
+const (
+	testVectorPassword        = "𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑"
+	testVectorEncodedPassword = "7465737470617373776f7264f09f9491"
+	testVectorSecret          = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+
+	scryptTestVector = `{
+    "crypto": {
+        "kdf": {
+            "function": "scrypt",
+            "params": {
+                "dklen": 32,
+                "n": 262144,
+                "p": 1,
+                "r": 8,
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "d2217fe5f3e9a1e34581ef8a78f7c9928e436d36dacc5e846690a5581e8ea484"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "06ae90d55fe0a6e9c5c3bc5b170827b2e5cce3929ed3f116c2811e6366dfe20f"
+        }
+    },
+    "description": "This is a test keystore that uses scrypt to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/3141592653/589793238",
+    "uuid": "1d85ae20-35c5-4611-98e8-aa14a633906f",
+    "version": 4
+}`
+
+	pbkdf2TestVector = `{
+    "crypto": {
+        "kdf": {
+            "function": "pbkdf2",
+            "params": {
+                "dklen": 32,
+                "c": 262144,
+                "prf": "hmac-sha256",
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+        }
+    },
+    "description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/0/0",
+    "uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+    "version": 4
+}`
+)
+
+// ScryptTestVector tests the EIP-2335 scrypt test vector
+func ScryptTestVector() *KeystoreSpecTest {
+	return &KeystoreSpecTest{
+		Name:            "scrypt test vector",
+		Keystore:        decodeTestVector(scryptTestVector),
+		Password:        testVectorPassword,
+		EncodedPassword: decodeHex(testVectorEncodedPassword),
+		Secret:          decodeHex(testVectorSecret),
+	}
+}
+
+// PBKDF2TestVector tests the EIP-2335 pbkdf2 test vector
+func PBKDF2TestVector() *KeystoreSpecTest {
+	return &KeystoreSpecTest{
+		Name:            "pbkdf2 test vector",
+		Keystore:        decodeTestVector(pbkdf2TestVector),
+		Password:        testVectorPassword,
+		EncodedPassword: decodeHex(testVectorEncodedPassword),
+		Secret:          decodeHex(testVectorSecret),
+	}
+}
+
+// NormalizedPassword tests the test vector's password after NFKD normalization decrypts the keystore
+func NormalizedPassword() *KeystoreSpecTest {
+	return &KeystoreSpecTest{
+		Name:            "normalized password",
+		Keystore:        decodeTestVector(pbkdf2TestVector),
+		Password:        "testpassword🔑",
+		EncodedPassword: decodeHex(testVectorEncodedPassword),
+		Secret:          decodeHex(testVectorSecret),
+	}
+}
+
+// PasswordControlCodes tests the C0, C1 and Delete control codes are stripped from the password
+func PasswordControlCodes() *KeystoreSpecTest {
+	return &KeystoreSpecTest{
+		Name:            "password control codes",
+		Keystore:        decodeTestVector(pbkdf2TestVector),
+		Password:        "\x00test\x1fpass\u007fword\u0085🔑\u009f",
+		EncodedPassword: decodeHex(testVectorEncodedPassword),
+		Secret:          decodeHex(testVectorSecret),
+	}
+}
+
+// WrongPassword tests decrypting with a wrong password
+func WrongPassword() *KeystoreSpecTest {
+	return &KeystoreSpecTest{
+		Name:          "wrong password",
+		Keystore:      decodeTestVector(pbkdf2TestVector),
+		Password:      "testpassword",
+		ExpectedError: "invalid checksum, wrong password",
+	}
+}
+
+// TamperedCipherMessage tests a cipher message not matching the checksum
+func TamperedCipherMessage() *KeystoreSpecTest {
+	ks := decodeTestVector(pbkdf2TestVector)
+	ks.Crypto.Cipher.Message = "dee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+	return &KeystoreSpecTest{
+		Name:          "tampered cipher message",
+		Keystore:      ks,
+		Password:      testVectorPassword,
+		ExpectedError: "invalid checksum, wrong password",
+	}
+}
+
+// PubKeyMismatch tests a keystore whose public key doesn't match its secret
+func PubKeyMismatch() *KeystoreSpecTest {
+	ks := decodeTestVector(pbkdf2TestVector)
+	ks.Pubkey = "8612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07"
+	return &KeystoreSpecTest{
+		Name:          "public key mismatch",
+		Keystore:      ks,
+		Password:      testVectorPassword,
+		ExpectedError: "share key doesn't match keystore public key",
+	}
+}
+
+// UnknownKDF tests a keystore with an unsupported KDF
+func UnknownKDF() *KeystoreSpecTest {
+	ks := decodeTestVector(pbkdf2TestVector)
+	ks.Crypto.KDF.Function = "argon2"
+	return &KeystoreSpecTest{
+		Name:          "unknown kdf",
+		Keystore:      ks,
+		Password:      testVectorPassword,
+		ExpectedError: "unknown kdf function",
+	}
+}
+
+// UnknownCipher tests a keystore with an unsupported cipher
+func UnknownCipher() *KeystoreSpecTest {
+	ks := decodeTestVector(pbkdf2TestVector)
+	ks.Crypto.Cipher.Function = "aes-256-gcm"
+	return &KeystoreSpecTest{
+		Name:          "unknown cipher",
+		Keystore:      ks,
+		Password:      testVectorPassword,
+		ExpectedError: "unknown cipher function",
+	}
+}
+
+func decodeTestVector(vector string) *types.Keystore {
+	ret := &types.Keystore{}
+	if err := json.Unmarshal([]byte(vector), ret); err != nil {
+		panic(err.Error())
+	}
+	return ret
+}
+
+func decodeHex(s string) []byte {
+	ret, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err.Error())
+	}
+	return ret
+}
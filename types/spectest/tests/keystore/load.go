@@ -0,0 +1,78 @@
+package keystore
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+const loadPassword = "share keystores password"
+
+// LoadKeystores tests loading a directory of keystores using both KDFs
+func LoadKeystores() *KeystoreLoadSpecTest {
+	keystores, pubKeys := shareKeystores(types.PBKDF2KDF, types.ScryptKDF, types.PBKDF2KDF)
+	return &KeystoreLoadSpecTest{
+		Name:            "load keystores",
+		Keystores:       keystores,
+		Password:        loadPassword,
+		ExpectedPubKeys: pubKeys,
+	}
+}
+
+// LoadEmptyDirectory tests loading a directory without keystores
+func LoadEmptyDirectory() *KeystoreLoadSpecTest {
+	return &KeystoreLoadSpecTest{
+		Name:            "load empty directory",
+		Keystores:       map[string]*types.Keystore{},
+		Password:        loadPassword,
+		ExpectedPubKeys: [][]byte{},
+	}
+}
+
+// LoadWrongPassword tests loading keystores with a wrong password
+func LoadWrongPassword() *KeystoreLoadSpecTest {
+	keystores, _ := shareKeystores(types.PBKDF2KDF, types.PBKDF2KDF)
+	return &KeystoreLoadSpecTest{
+		Name:          "load wrong password",
+		Keystores:     keystores,
+		Password:      "wrong password",
+		ExpectedError: "could not decrypt keystore share_1.json: invalid checksum, wrong password",
+	}
+}
+
+// LoadPubKeyMismatch tests loading a keystore whose public key doesn't match its secret
+func LoadPubKeyMismatch() *KeystoreLoadSpecTest {
+	keystores, pubKeys := shareKeystores(types.PBKDF2KDF, types.PBKDF2KDF)
+	keystores["share_2.json"].Pubkey = fmt.Sprintf("%x", pubKeys[0])
+	return &KeystoreLoadSpecTest{
+		Name:          "load public key mismatch",
+		Keystores:     keystores,
+		Password:      loadPassword,
+		ExpectedError: "could not decrypt keystore share_2.json: share key doesn't match keystore public key",
+	}
+}
+
+// shareKeystores returns keystores of share keys (share_<i>.json) encrypted with the KDFs, and their public keys
+func shareKeystores(kdfs ...string) (map[string]*types.Keystore, [][]byte) {
+	types.InitBLS()
+
+	keystores := make(map[string]*types.Keystore)
+	pubKeys := make([][]byte, 0, len(kdfs))
+	for i, kdf := range kdfs {
+		shareKey := &bls.SecretKey{}
+		seed := sha256.Sum256([]byte(fmt.Sprintf("keystore share %d", i+1)))
+		if err := shareKey.SetLittleEndianMod(seed[:]); err != nil {
+			panic(err.Error())
+		}
+
+		ks, err := types.NewKeystore(shareKey, loadPassword, kdf)
+		if err != nil {
+			panic(err.Error())
+		}
+		keystores[fmt.Sprintf("share_%d.json", i+1)] = ks
+		pubKeys = append(pubKeys, shareKey.GetPublicKey().Serialize())
+	}
+	return keystores, pubKeys
+}
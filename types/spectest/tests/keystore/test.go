@@ -0,0 +1,44 @@
+package keystore
+
+import (
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+// KeystoreSpecTest tests decrypting an EIP-2335 keystore with the password, and re-encrypting the secret with the keystore's params
+type KeystoreSpecTest struct {
+	Name     string
+	Keystore *types.Keystore
+	Password string
+	// EncodedPassword is the password's normalized encoding, not checked if empty
+	EncodedPassword []byte
+	Secret          []byte
+	ExpectedError   string
+}
+
+func (test *KeystoreSpecTest) TestName() string {
+	return "keystore " + test.Name
+}
+
+func (test *KeystoreSpecTest) Run(t *testing.T) {
+	if len(test.EncodedPassword) != 0 {
+		require.EqualValues(t, test.EncodedPassword, types.NormalizeKeystorePassword(test.Password))
+	}
+
+	shareKey, err := test.Keystore.DecryptShareKey(test.Password)
+	if len(test.ExpectedError) != 0 {
+		require.EqualError(t, err, test.ExpectedError)
+		return
+	}
+	require.NoError(t, err)
+	require.EqualValues(t, test.Secret, shareKey.Serialize())
+
+	// encrypting the secret with the keystore's KDF params and IV results in the same messages
+	encrypted := *test.Keystore
+	encrypted.Crypto.Cipher.Message = ""
+	encrypted.Crypto.Checksum.Message = ""
+	require.NoError(t, encrypted.Encrypt(test.Secret, test.Password))
+	require.EqualValues(t, test.Keystore.Crypto, encrypted.Crypto)
+}
@@ -0,0 +1,48 @@
+package keystore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// KeystoreLoadSpecTest tests loading a directory of keystores (file name to keystore) into a key manager
+type KeystoreLoadSpecTest struct {
+	Name            string
+	Keystores       map[string]*types.Keystore
+	Password        string
+	ExpectedPubKeys [][]byte
+	ExpectedError   string
+}
+
+func (test *KeystoreLoadSpecTest) TestName() string {
+	return "keystore load " + test.Name
+}
+
+func (test *KeystoreLoadSpecTest) Run(t *testing.T) {
+	dir := t.TempDir()
+	for name, ks := range test.Keystores {
+		byts, err := ks.Encode()
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), byts, 0600))
+	}
+
+	km := testingutils.NewTestingKeyManager()
+	pubKeys, err := types.LoadKeystores(km, dir, test.Password)
+	if len(test.ExpectedError) != 0 {
+		require.EqualError(t, err, test.ExpectedError)
+		return
+	}
+	require.NoError(t, err)
+	require.EqualValues(t, test.ExpectedPubKeys, pubKeys)
+
+	// the loaded share keys sign
+	for _, pk := range pubKeys {
+		_, err := km.SignRoot(types.PartialSignatureMessages{}, types.PartialSignatureType, pk)
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,28 @@
+package share
+
+import (
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+// ShareValidationTest tests the share's consistency: committee, quorums and share public keys interpolating to the validator public key
+type ShareValidationTest struct {
+	Name          string
+	Share         types.Share
+	ExpectedError string
+}
+
+func (test *ShareValidationTest) TestName() string {
+	return "share validation " + test.Name
+}
+
+func (test *ShareValidationTest) Run(t *testing.T) {
+	err := test.Share.Validate()
+	if len(test.ExpectedError) != 0 {
+		require.EqualError(t, err, test.ExpectedError)
+	} else {
+		require.NoError(t, err)
+	}
+}
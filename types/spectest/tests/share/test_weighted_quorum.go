@@ -0,0 +1,54 @@
+package share
+
+import (
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+// WeightedQuorumTest tests the voting power quorums of a share and the fault tolerance they provide.
+// For total voting power W and max faulty voting power f (3f < W), quorum q and partial quorum f+1:
+//   - safety: any two quorums intersect with more than f voting power (2q - W > f), so with at least one honest operator
+//   - liveness: the honest voting power (at least W - f) reaches quorum (W - f >= q)
+//   - partial quorum: f+1 voting power includes at least one honest operator
+//
+// Both hold only if no single operator holds more than f voting power, the share must be valid (see types.Share.Validate).
+type WeightedQuorumTest struct {
+	Name                     string
+	Share                    types.Share
+	Signers                  []types.OperatorID
+	ExpectedTotalWeight      uint64
+	ExpectedQuorum           uint64
+	ExpectedPartialQuorum    uint64
+	ExpectedMinQuorumSize    uint64
+	ExpectedHasQuorum        bool
+	ExpectedHasPartialQuorum bool
+}
+
+func (test *WeightedQuorumTest) TestName() string {
+	return "share weighted quorum " + test.Name
+}
+
+func (test *WeightedQuorumTest) Run(t *testing.T) {
+	require.NoError(t, test.Share.Validate())
+
+	totalWeight := test.Share.TotalWeight()
+	require.EqualValues(t, test.ExpectedTotalWeight, totalWeight)
+
+	quorum, partialQuorum := types.ComputeQuorum(totalWeight)
+	require.EqualValues(t, test.ExpectedQuorum, quorum)
+	require.EqualValues(t, test.ExpectedPartialQuorum, partialQuorum)
+	require.EqualValues(t, quorum, test.Share.Quorum)
+	require.EqualValues(t, partialQuorum, test.Share.PartialQuorum)
+	require.EqualValues(t, test.ExpectedMinQuorumSize, test.Share.MinQuorumSize())
+
+	// fault tolerance
+	f := partialQuorum - 1
+	require.Less(t, 3*f, totalWeight)
+	require.Greater(t, 2*quorum-totalWeight, f)
+	require.GreaterOrEqual(t, totalWeight-f, quorum)
+
+	require.Equal(t, test.ExpectedHasQuorum, test.Share.SignersHaveQuorum(test.Signers))
+	require.Equal(t, test.ExpectedHasPartialQuorum, test.Share.SignersHavePartialQuorum(test.Signers))
+}
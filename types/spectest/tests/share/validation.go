@@ -0,0 +1,174 @@
+package share
+
+import (
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// ValidationValid4Operators tests a valid 4 operators share
+func ValidationValid4Operators() *ShareValidationTest {
+	return &ShareValidationTest{
+		Name:  "valid 4 operators",
+		Share: *testingutils.TestingShare(testingutils.Testing4SharesSet()),
+	}
+}
+
+// ValidationValid13Operators tests a valid 13 operators share
+func ValidationValid13Operators() *ShareValidationTest {
+	return &ShareValidationTest{
+		Name:  "valid 13 operators",
+		Share: *testingutils.TestingShare(testingutils.Testing13SharesSet()),
+	}
+}
+
+// ValidationValidWeighted tests a valid weighted share, the key threshold (3) doesn't exceed the min quorum size (weights [2,2,2,1], q = 5)
+func ValidationValidWeighted() *ShareValidationTest {
+	return &ShareValidationTest{
+		Name:  "valid weighted",
+		Share: *testingutils.TestingWeightedShare(testingutils.Testing4SharesSet(), map[types.OperatorID]uint64{1: 2, 2: 2, 3: 2}),
+	}
+}
+
+// ValidationOperatorWeightAboveF tests an operator holding more than the max faulty voting power (weights [2,1,1,1], W = 5, f = 1),
+// that one faulty operator would reach partial quorum alone and break the quorums' intersection
+func ValidationOperatorWeightAboveF() *ShareValidationTest {
+	return &ShareValidationTest{
+		Name:          "operator weight above f",
+		Share:         *testingutils.TestingWeightedShare(testingutils.Testing4SharesSet(), map[types.OperatorID]uint64{1: 2}),
+		ExpectedError: "operator 1 voting power exceeds the max faulty voting power 1",
+	}
+}
+
+// ValidationInvalidCommitteeSize tests a committee which isn't 3f+1 operators
+func ValidationInvalidCommitteeSize() *ShareValidationTest {
+	share := testingutils.TestingShare(testingutils.Testing7SharesSet())
+	share.Committee = share.Committee[:5]
+	return &ShareValidationTest{
+		Name:          "invalid committee size",
+		Share:         *share,
+		ExpectedError: "invalid committee size",
+	}
+}
+
+// ValidationUnsortedCommittee tests a committee not sorted by operator id
+func ValidationUnsortedCommittee() *ShareValidationTest {
+	share := testingutils.TestingShare(testingutils.Testing4SharesSet())
+	share.Committee[0], share.Committee[1] = share.Committee[1], share.Committee[0]
+	return &ShareValidationTest{
+		Name:          "unsorted committee",
+		Share:         *share,
+		ExpectedError: "committee not sorted by unique operator ids",
+	}
+}
+
+// ValidationDuplicateOperator tests a committee with a duplicate operator
+func ValidationDuplicateOperator() *ShareValidationTest {
+	share := testingutils.TestingShare(testingutils.Testing4SharesSet())
+	share.Committee[2] = share.Committee[1]
+	return &ShareValidationTest{
+		Name:          "duplicate operator",
+		Share:         *share,
+		ExpectedError: "committee not sorted by unique operator ids",
+	}
+}
+
+// ValidationOperatorNotInCommittee tests a share of an operator not in the committee
+func ValidationOperatorNotInCommittee() *ShareValidationTest {
+	share := testingutils.TestingShare(testingutils.Testing4SharesSet())
+	share.OperatorID = 5
+	return &ShareValidationTest{
+		Name:          "operator not in committee",
+		Share:         *share,
+		ExpectedError: "operator not in committee",
+	}
+}
+
+// ValidationSharePubKeyMismatch tests a share public key other than the operator's in the committee
+func ValidationSharePubKeyMismatch() *ShareValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	share := testingutils.TestingShare(ks)
+	share.SharePubKey = ks.Shares[2].GetPublicKey().Serialize()
+	return &ShareValidationTest{
+		Name:          "share public key mismatch",
+		Share:         *share,
+		ExpectedError: "share public key doesn't match committee",
+	}
+}
+
+// ValidationWrongQuorum tests a quorum not matching the committee size
+func ValidationWrongQuorum() *ShareValidationTest {
+	share := testingutils.TestingShare(testingutils.Testing7SharesSet())
+	share.Quorum = 4
+	return &ShareValidationTest{
+		Name:          "wrong quorum",
+		Share:         *share,
+		ExpectedError: "invalid quorum",
+	}
+}
+
+// ValidationWrongValidatorPubKey tests share public keys of another validator
+func ValidationWrongValidatorPubKey() *ShareValidationTest {
+	ks := testingutils.Testing4SharesSet()
+	share := testingutils.TestingShare(ks)
+	share.ValidatorPubKey = ks.Shares[1].GetPublicKey().Serialize()
+	return &ShareValidationTest{
+		Name:          "wrong validator public key",
+		Share:         *share,
+		ExpectedError: "share public keys don't interpolate to validator public key",
+	}
+}
+
+// ValidationWrongOperatorSharePubKey tests a committee operator whose share public key isn't on the validator's polynomial
+func ValidationWrongOperatorSharePubKey() *ShareValidationTest {
+	ks := testingutils.Testing7SharesSet()
+	share := testingutils.TestingShare(ks)
+	share.Committee[6].SharePubKey = ks.Refreshed().Shares[7].GetPublicKey().Serialize()
+	return &ShareValidationTest{
+		Name:          "wrong operator share public key",
+		Share:         *share,
+		ExpectedError: "operator 7 share public key doesn't interpolate to validator public key",
+	}
+}
+
+// ValidationInvalidSharePubKey tests a committee share public key which isn't a public key
+func ValidationInvalidSharePubKey() *ShareValidationTest {
+	share := testingutils.TestingShare(testingutils.Testing4SharesSet())
+	share.Committee[3].SharePubKey = make([]byte, 48)
+	return &ShareValidationTest{
+		Name:          "invalid share public key",
+		Share:         *share,
+		ExpectedError: "invalid operator 4 share public key: err blsPublicKeyDeserialize 000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+	}
+}
+
+// ValidationThresholdAboveMinQuorum tests a weighted committee four of whose operators reach quorum (weights [2,2,1,1,1,1,1], q = 6)
+// while the key threshold is 5, a quorum of them can't reconstruct a signature
+func ValidationThresholdAboveMinQuorum() *ShareValidationTest {
+	return &ShareValidationTest{
+		Name:          "threshold above min quorum",
+		Share:         *testingutils.TestingWeightedShare(testingutils.Testing7SharesSet(), map[types.OperatorID]uint64{1: 2, 2: 2}),
+		ExpectedError: "share public keys don't interpolate to validator public key",
+	}
+}
+
+// ValidationRotatingOperator tests a valid share of a committee whose operator is rotating its SSV operator key
+func ValidationRotatingOperator() *ShareValidationTest {
+	share := testingutils.TestingShare(testingutils.Testing4SharesSet())
+	share.Committee[1] = testingutils.TestingRotatingOperator(share.Committee[1], types.Ed25519OperatorKey, 10)
+	return &ShareValidationTest{
+		Name:  "rotating operator",
+		Share: *share,
+	}
+}
+
+// ValidationNextKeyNotAfterCurrentKey tests an operator whose next SSV operator key isn't activated after its current key
+func ValidationNextKeyNotAfterCurrentKey() *ShareValidationTest {
+	share := testingutils.TestingShare(testingutils.Testing4SharesSet())
+	share.Committee[1] = testingutils.TestingRotatingOperator(share.Committee[1], types.Ed25519OperatorKey, 10)
+	share.Committee[1].SSVOperatorKeyEpoch = 10
+	return &ShareValidationTest{
+		Name:          "next key not after current key",
+		Share:         *share,
+		ExpectedError: "next operator key not activated after current key",
+	}
+}
@@ -0,0 +1,120 @@
+package share
+
+import (
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// heavyWeights are 4 operators' voting power where no operator holds more than f: weights [2,2,2,1], W = 7, f = 2, q = 5
+var heavyWeights = map[types.OperatorID]uint64{1: 2, 2: 2, 3: 2}
+
+// WeightedQuorumEqualWeights tests a committee with equal voting power has the 2f+1 and f+1 quorums (W = 4, f = 1, q = 3)
+func WeightedQuorumEqualWeights() *WeightedQuorumTest {
+	return &WeightedQuorumTest{
+		Name:                     "equal weights",
+		Share:                    *testingutils.TestingShare(testingutils.Testing4SharesSet()),
+		Signers:                  []types.OperatorID{1, 2, 3},
+		ExpectedTotalWeight:      4,
+		ExpectedQuorum:           3,
+		ExpectedPartialQuorum:    2,
+		ExpectedMinQuorumSize:    3,
+		ExpectedHasQuorum:        true,
+		ExpectedHasPartialQuorum: true,
+	}
+}
+
+// WeightedQuorumHeavySigner tests a quorum reached by two heavy operators and the light one (weights [2,2,2,1], W = 7, f = 2, q = 5).
+// Two quorums intersect with at least 2q - W = 3 > f voting power, honest voting power W - f = 5 >= q.
+// Faulty voting power f = 2 means any single operator can be faulty.
+func WeightedQuorumHeavySigner() *WeightedQuorumTest {
+	return &WeightedQuorumTest{
+		Name:                     "heavy signer",
+		Share:                    *testingutils.TestingWeightedShare(testingutils.Testing4SharesSet(), heavyWeights),
+		Signers:                  []types.OperatorID{1, 2, 4},
+		ExpectedTotalWeight:      7,
+		ExpectedQuorum:           5,
+		ExpectedPartialQuorum:    3,
+		ExpectedMinQuorumSize:    3,
+		ExpectedHasQuorum:        true,
+		ExpectedHasPartialQuorum: true,
+	}
+}
+
+// WeightedQuorumLightSigners tests 5 light operators don't have quorum (weights [2,1,1,1,1,1,1], W = 8, f = 2, q = 6).
+// With equal voting power 5 of 7 operators would have quorum
+func WeightedQuorumLightSigners() *WeightedQuorumTest {
+	return &WeightedQuorumTest{
+		Name:                     "light signers",
+		Share:                    *testingutils.TestingWeightedShare(testingutils.Testing7SharesSet(), map[types.OperatorID]uint64{1: 2}),
+		Signers:                  []types.OperatorID{2, 3, 4, 5, 6},
+		ExpectedTotalWeight:      8,
+		ExpectedQuorum:           6,
+		ExpectedPartialQuorum:    3,
+		ExpectedMinQuorumSize:    5,
+		ExpectedHasQuorum:        false,
+		ExpectedHasPartialQuorum: true,
+	}
+}
+
+// WeightedQuorumHeavyNoPartialQuorum tests a heavy operator alone doesn't have partial quorum (weights [2,2,2,1], W = 7, f + 1 = 3),
+// no single operator holds more than f voting power so a single faulty operator can't reach f + 1
+func WeightedQuorumHeavyNoPartialQuorum() *WeightedQuorumTest {
+	return &WeightedQuorumTest{
+		Name:                     "heavy no partial quorum",
+		Share:                    *testingutils.TestingWeightedShare(testingutils.Testing4SharesSet(), heavyWeights),
+		Signers:                  []types.OperatorID{1},
+		ExpectedTotalWeight:      7,
+		ExpectedQuorum:           5,
+		ExpectedPartialQuorum:    3,
+		ExpectedMinQuorumSize:    3,
+		ExpectedHasQuorum:        false,
+		ExpectedHasPartialQuorum: false,
+	}
+}
+
+// WeightedQuorumDuplicateSigner tests duplicate signers are counted once (weights [2,2,2,1], W = 7, q = 5)
+func WeightedQuorumDuplicateSigner() *WeightedQuorumTest {
+	return &WeightedQuorumTest{
+		Name:                     "duplicate signer",
+		Share:                    *testingutils.TestingWeightedShare(testingutils.Testing4SharesSet(), heavyWeights),
+		Signers:                  []types.OperatorID{1, 2, 2},
+		ExpectedTotalWeight:      7,
+		ExpectedQuorum:           5,
+		ExpectedPartialQuorum:    3,
+		ExpectedMinQuorumSize:    3,
+		ExpectedHasQuorum:        false,
+		ExpectedHasPartialQuorum: true,
+	}
+}
+
+// WeightedQuorumUnknownSigner tests signers not in the committee have no voting power (weights [2,2,2,1], W = 7, q = 5)
+func WeightedQuorumUnknownSigner() *WeightedQuorumTest {
+	return &WeightedQuorumTest{
+		Name:                     "unknown signer",
+		Share:                    *testingutils.TestingWeightedShare(testingutils.Testing4SharesSet(), heavyWeights),
+		Signers:                  []types.OperatorID{1, 2, 5},
+		ExpectedTotalWeight:      7,
+		ExpectedQuorum:           5,
+		ExpectedPartialQuorum:    3,
+		ExpectedMinQuorumSize:    3,
+		ExpectedHasQuorum:        false,
+		ExpectedHasPartialQuorum: true,
+	}
+}
+
+// WeightedQuorumSevenOperators tests a 7 operators committee (weights [2,1,1,1,1,1,1], W = 8, f = 2, q = 6).
+// Two quorums intersect with at least 2q - W = 4 > f voting power, honest voting power W - f = 6 >= q.
+// Any quorum has at least 5 operators (the key set threshold), so a quorum of partial signatures can always be reconstructed.
+func WeightedQuorumSevenOperators() *WeightedQuorumTest {
+	return &WeightedQuorumTest{
+		Name:                     "seven operators",
+		Share:                    *testingutils.TestingWeightedShare(testingutils.Testing7SharesSet(), map[types.OperatorID]uint64{1: 2}),
+		Signers:                  []types.OperatorID{1, 2, 3, 4, 5},
+		ExpectedTotalWeight:      8,
+		ExpectedQuorum:           6,
+		ExpectedPartialQuorum:    3,
+		ExpectedMinQuorumSize:    5,
+		ExpectedHasQuorum:        true,
+		ExpectedHasPartialQuorum: true,
+	}
+}
@@ -10,10 +10,10 @@ func EmptySignature() *SignedSSVMessageTest {
 		Messages: []*types.SignedSSVMessage{
 			{
 				OperatorID: 1,
-				Signature:  [256]byte{},
+				Signature:  []byte{},
 				Data:       []byte{1, 2, 3, 4},
 			},
 		},
-		ExpectedError: "could not decode SSVMessage from data in SignedSSVMessage: incorrect size",
+		ExpectedError: "empty signature",
 	}
 }
@@ -0,0 +1,127 @@
+package signedssvmsg
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// keyRotationEpoch is the activation epoch of operator 1's next (Ed25519) SSV operator key in the key rotation tests
+const keyRotationEpoch = phase0.Epoch(10)
+
+// keyRotationCommittee returns the committee with operator 1 rotating its RSA operator key to an Ed25519 one at keyRotationEpoch
+func keyRotationCommittee(ks *testingutils.TestKeySet) []*types.Operator {
+	committee := testingutils.TestingShare(ks).Committee
+	committee[0] = testingutils.TestingRotatingOperator(committee[0], types.Ed25519OperatorKey, keyRotationEpoch)
+	return committee
+}
+
+// keyRotationMessage returns operator 1's SignedSSVMessage signed at the epoch, verified at another
+func keyRotationMessage(ks *testingutils.TestKeySet, signedAt phase0.Epoch, verifiedAt phase0.Epoch, expectedError string) *KeyRotationMessage {
+	ssvMsg := testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, qbft.FirstHeight))
+	signer := testingutils.NewTestingRotatingOperatorSigner(ks, 1, types.Ed25519OperatorKey, keyRotationEpoch, signedAt)
+	msg, err := types.SSVMessageToSignedSSVMessage(ssvMsg, 1, signer.SignSSVMessage)
+	if err != nil {
+		panic(err)
+	}
+	return &KeyRotationMessage{
+		Message:       msg,
+		SignedAt:      signedAt,
+		VerifiedAt:    verifiedAt,
+		ExpectedError: expectedError,
+	}
+}
+
+// KeyRotationBeforeActivation tests a message signed and verified with the current key before the rotation's overlap window
+func KeyRotationBeforeActivation() *KeyRotationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &KeyRotationTest{
+		Name:      "key rotation before activation",
+		Committee: keyRotationCommittee(ks),
+		Messages: []*KeyRotationMessage{
+			keyRotationMessage(ks, 5, 5, ""),
+		},
+	}
+}
+
+// KeyRotationCurrentKeyInOverlap tests messages signed with the current key, verified within the overlap window after the activation
+func KeyRotationCurrentKeyInOverlap() *KeyRotationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &KeyRotationTest{
+		Name:      "key rotation current key in overlap",
+		Committee: keyRotationCommittee(ks),
+		Messages: []*KeyRotationMessage{
+			keyRotationMessage(ks, keyRotationEpoch-1, keyRotationEpoch-1, ""),
+			keyRotationMessage(ks, keyRotationEpoch-1, keyRotationEpoch, ""),
+		},
+	}
+}
+
+// KeyRotationCurrentKeyExpired tests a message signed with the current key, verified after the overlap window
+func KeyRotationCurrentKeyExpired() *KeyRotationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &KeyRotationTest{
+		Name:      "key rotation current key expired",
+		Committee: keyRotationCommittee(ks),
+		Messages: []*KeyRotationMessage{
+			keyRotationMessage(ks, keyRotationEpoch-1, keyRotationEpoch+types.KeyRotationOverlapEpochs, "invalid ed25519 signature size"),
+		},
+	}
+}
+
+// KeyRotationNextKeyAtActivation tests messages signed with the next key from the activation epoch on
+func KeyRotationNextKeyAtActivation() *KeyRotationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &KeyRotationTest{
+		Name:      "key rotation next key at activation",
+		Committee: keyRotationCommittee(ks),
+		Messages: []*KeyRotationMessage{
+			keyRotationMessage(ks, keyRotationEpoch, keyRotationEpoch, ""),
+			keyRotationMessage(ks, keyRotationEpoch+5, keyRotationEpoch+5, ""),
+		},
+	}
+}
+
+// KeyRotationNextKeyInOverlap tests a message signed with the next key, verified within the overlap window before the activation
+// (the verifier's clock is behind the signer's)
+func KeyRotationNextKeyInOverlap() *KeyRotationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &KeyRotationTest{
+		Name:      "key rotation next key in overlap",
+		Committee: keyRotationCommittee(ks),
+		Messages: []*KeyRotationMessage{
+			keyRotationMessage(ks, keyRotationEpoch, keyRotationEpoch-types.KeyRotationOverlapEpochs, ""),
+		},
+	}
+}
+
+// KeyRotationNextKeyTooEarly tests a message signed with the next key, verified before the overlap window
+func KeyRotationNextKeyTooEarly() *KeyRotationTest {
+	ks := testingutils.Testing4SharesSet()
+	return &KeyRotationTest{
+		Name:      "key rotation next key too early",
+		Committee: keyRotationCommittee(ks),
+		Messages: []*KeyRotationMessage{
+			keyRotationMessage(ks, keyRotationEpoch, keyRotationEpoch-types.KeyRotationOverlapEpochs-1, "invalid rsa signature size"),
+		},
+	}
+}
+
+// KeyRotationWrongKeyInOverlap tests a message signed with neither of the operator's keys, verified within the overlap window
+func KeyRotationWrongKeyInOverlap() *KeyRotationTest {
+	ks := testingutils.Testing4SharesSet()
+	ssvMsg := testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[1], 1, qbft.FirstHeight))
+	return &KeyRotationTest{
+		Name:      "key rotation wrong key in overlap",
+		Committee: keyRotationCommittee(ks),
+		Messages: []*KeyRotationMessage{
+			{
+				Message:       testingutils.SignedSSVMessageWithKeyType(ks, 1, types.Secp256k1OperatorKey, ssvMsg),
+				SignedAt:      keyRotationEpoch,
+				VerifiedAt:    keyRotationEpoch,
+				ExpectedError: "invalid rsa signature size",
+			},
+		},
+	}
+}
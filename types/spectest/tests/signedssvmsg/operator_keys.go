@@ -0,0 +1,114 @@
+package signedssvmsg
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/ssvlabs/ssv-spec/qbft"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+)
+
+// operatorKeyMessage returns a SignedSSVMessage of the operator signed with its SSV operator key of the key type
+func operatorKeyMessage(ks *testingutils.TestKeySet, operatorID types.OperatorID, keyType types.OperatorKeyType) *types.SignedSSVMessage {
+	ssvMsg := testingutils.SSVMsgAttester(nil, testingutils.PostConsensusAttestationMsg(ks.Shares[operatorID], operatorID, qbft.FirstHeight))
+	return testingutils.SignedSSVMessageWithKeyType(ks, operatorID, keyType, ssvMsg)
+}
+
+// Ed25519Key tests a valid SignedSSVMessage signed with an Ed25519 operator key
+func Ed25519Key() *OperatorKeyTest {
+	ks := testingutils.Testing4SharesSet()
+	share := testingutils.TestingMixedKeysShare(ks, map[types.OperatorID]types.OperatorKeyType{1: types.Ed25519OperatorKey})
+
+	return &OperatorKeyTest{
+		Name:      "ed25519 operator key",
+		Committee: share.Committee,
+		Messages: []*types.SignedSSVMessage{
+			operatorKeyMessage(ks, 1, types.Ed25519OperatorKey),
+		},
+	}
+}
+
+// Secp256k1Key tests a valid SignedSSVMessage signed with a secp256k1 operator key
+func Secp256k1Key() *OperatorKeyTest {
+	ks := testingutils.Testing4SharesSet()
+	share := testingutils.TestingMixedKeysShare(ks, map[types.OperatorID]types.OperatorKeyType{1: types.Secp256k1OperatorKey})
+
+	return &OperatorKeyTest{
+		Name:      "secp256k1 operator key",
+		Committee: share.Committee,
+		Messages: []*types.SignedSSVMessage{
+			operatorKeyMessage(ks, 1, types.Secp256k1OperatorKey),
+		},
+	}
+}
+
+// MixedKeysCommittee tests valid SignedSSVMessages of a committee whose operators have RSA, Ed25519 and secp256k1 operator keys
+func MixedKeysCommittee() *OperatorKeyTest {
+	ks := testingutils.Testing4SharesSet()
+	keyTypes := map[types.OperatorID]types.OperatorKeyType{
+		2: types.Ed25519OperatorKey,
+		3: types.Secp256k1OperatorKey,
+		4: types.Ed25519OperatorKey,
+	}
+	share := testingutils.TestingMixedKeysShare(ks, keyTypes)
+
+	return &OperatorKeyTest{
+		Name:      "mixed keys committee",
+		Committee: share.Committee,
+		Messages: []*types.SignedSSVMessage{
+			operatorKeyMessage(ks, 1, types.RSAOperatorKey),
+			operatorKeyMessage(ks, 2, types.Ed25519OperatorKey),
+			operatorKeyMessage(ks, 3, types.Secp256k1OperatorKey),
+			operatorKeyMessage(ks, 4, types.Ed25519OperatorKey),
+		},
+	}
+}
+
+// WrongKeyType tests a SignedSSVMessage signed with a secp256k1 key by an operator with an Ed25519 operator key
+func WrongKeyType() *OperatorKeyTest {
+	ks := testingutils.Testing4SharesSet()
+	share := testingutils.TestingMixedKeysShare(ks, map[types.OperatorID]types.OperatorKeyType{1: types.Ed25519OperatorKey})
+
+	return &OperatorKeyTest{
+		Name:      "wrong operator key type",
+		Committee: share.Committee,
+		Messages: []*types.SignedSSVMessage{
+			operatorKeyMessage(ks, 1, types.Secp256k1OperatorKey),
+		},
+		ExpectedError: "invalid ed25519 signature",
+	}
+}
+
+// WrongSignatureSize tests a SignedSSVMessage with an Ed25519 signature by an operator with an RSA operator key
+func WrongSignatureSize() *OperatorKeyTest {
+	ks := testingutils.Testing4SharesSet()
+
+	return &OperatorKeyTest{
+		Name:      "wrong signature size",
+		Committee: testingutils.TestingShare(ks).Committee,
+		Messages: []*types.SignedSSVMessage{
+			operatorKeyMessage(ks, 1, types.Ed25519OperatorKey),
+		},
+		ExpectedError: "invalid rsa signature size",
+	}
+}
+
+// Secp256k1HighS tests a secp256k1 signature with a high s (n - s), valid ECDSA but rejected as malleable
+func Secp256k1HighS() *OperatorKeyTest {
+	ks := testingutils.Testing4SharesSet()
+	share := testingutils.TestingMixedKeysShare(ks, map[types.OperatorID]types.OperatorKeyType{1: types.Secp256k1OperatorKey})
+
+	msg := operatorKeyMessage(ks, 1, types.Secp256k1OperatorKey)
+	var s secp256k1.ModNScalar
+	s.SetByteSlice(msg.Signature[32:])
+	highS := s.Negate().Bytes()
+	msg.Signature = append(append([]byte{}, msg.Signature[:32]...), highS[:]...)
+
+	return &OperatorKeyTest{
+		Name:      "secp256k1 high s",
+		Committee: share.Committee,
+		Messages: []*types.SignedSSVMessage{
+			msg,
+		},
+		ExpectedError: "invalid secp256k1 signature",
+	}
+}
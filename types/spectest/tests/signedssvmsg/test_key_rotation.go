@@ -0,0 +1,50 @@
+package signedssvmsg
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// KeyRotationMessage is a SignedSSVMessage verified at an epoch of a key rotation
+type KeyRotationMessage struct {
+	Message *types.SignedSSVMessage
+	// SignedAt is the epoch the operator signed the message at, selecting its current or next SSV operator key
+	SignedAt phase0.Epoch
+	// VerifiedAt is the epoch the message is verified at
+	VerifiedAt    phase0.Epoch
+	ExpectedError string
+}
+
+// KeyRotationTest verifies SignedSSVMessages of operators rotating their SSV operator keys, signed and verified at epochs
+// around the rotation's activation epoch
+type KeyRotationTest struct {
+	Name      string
+	Committee []*types.Operator
+	Messages  []*KeyRotationMessage
+}
+
+func (test *KeyRotationTest) TestName() string {
+	return "signedssvmsg " + test.Name
+}
+
+func (test *KeyRotationTest) Run(t *testing.T) {
+	for _, msg := range test.Messages {
+		epoch := msg.VerifiedAt
+
+		// check the signature with the testing verifier and the operator signature verifier at the epoch
+		err := testingutils.NewTestingVerifierAtEpoch(epoch).Verify(msg.Message, test.Committee)
+		verifierErr := types.NewOperatorSignatureVerifier(0, 0, func() phase0.Epoch { return epoch }).Verify(msg.Message, test.Committee)
+
+		if len(msg.ExpectedError) != 0 {
+			require.EqualError(t, err, msg.ExpectedError)
+			require.EqualError(t, verifierErr, msg.ExpectedError)
+		} else {
+			require.NoError(t, err)
+			require.NoError(t, verifierErr)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package signedssvmsg
+
+import (
+	"testing"
+
+	"github.com/ssvlabs/ssv-spec/types"
+	"github.com/ssvlabs/ssv-spec/types/testingutils"
+	"github.com/stretchr/testify/require"
+)
+
+// OperatorKeyTest verifies SignedSSVMessages signed with the committee operators' SSV operator keys, of any key type
+type OperatorKeyTest struct {
+	Name          string
+	Committee     []*types.Operator
+	Messages      []*types.SignedSSVMessage
+	ExpectedError string
+}
+
+func (test *OperatorKeyTest) TestName() string {
+	return "signedssvmsg " + test.Name
+}
+
+func (test *OperatorKeyTest) Run(t *testing.T) {
+	verifier := testingutils.NewTestingVerifier()
+
+	for _, msg := range test.Messages {
+
+		// test validation
+		err := msg.Validate()
+
+		// encoding round trip
+		if err == nil {
+			var byts []byte
+			byts, err = msg.Encode()
+			if err == nil {
+				decoded := &types.SignedSSVMessage{}
+				err = decoded.Decode(byts)
+				if err == nil {
+					require.EqualValues(t, msg, decoded)
+				}
+			}
+		}
+
+		// check the signature with the operator's key type
+		if err == nil {
+			err = verifier.Verify(msg, test.Committee)
+		}
+
+		if len(test.ExpectedError) != 0 {
+			require.EqualError(t, err, test.ExpectedError)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+}
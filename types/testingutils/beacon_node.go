@@ -481,6 +481,7 @@ var TestingWrongDutyPK = types.Duty{
 type TestingBeaconNode struct {
 	BroadcastedRoots             []phase0.Root
 	syncCommitteeAggregatorRoots map[string]bool
+	liveEpochs                   map[phase0.Epoch]bool
 }
 
 func NewTestingBeaconNode() *TestingBeaconNode {
@@ -494,6 +495,14 @@ func (bn *TestingBeaconNode) SetSyncCommitteeAggregatorRootHexes(roots map[strin
 	bn.syncCommitteeAggregatorRoots = roots
 }
 
+// SetLiveEpochs FOR TESTING ONLY!! sets the epochs in which the validator will be reported as live
+func (bn *TestingBeaconNode) SetLiveEpochs(epochs []phase0.Epoch) {
+	bn.liveEpochs = make(map[phase0.Epoch]bool)
+	for _, epoch := range epochs {
+		bn.liveEpochs[epoch] = true
+	}
+}
+
 // GetBeaconNetwork returns the beacon network the node is on
 func (bn *TestingBeaconNode) GetBeaconNetwork() types.BeaconNetwork {
 	return types.BeaconTestNetwork
@@ -673,6 +682,11 @@ func (bn *TestingBeaconNode) SubmitSignedContributionAndProof(contribution *alta
 	return nil
 }
 
+// GetValidatorLiveness returns true if the validator was seen live on chain in the given epoch
+func (bn *TestingBeaconNode) GetValidatorLiveness(pubKey phase0.BLSPubKey, epoch phase0.Epoch) (bool, error) {
+	return bn.liveEpochs[epoch], nil
+}
+
 func (bn *TestingBeaconNode) DomainData(epoch phase0.Epoch, domain phase0.DomainType) (phase0.Domain, error) {
 	// epoch is used to calculate fork version, here we hard code it
 	return types.ComputeETHDomain(domain, types.GenesisForkVersion, types.GenesisValidatorsRoot)
@@ -0,0 +1,59 @@
+package testingutils
+
+import (
+	"crypto/x509"
+	"sort"
+
+	"github.com/ssvlabs/ssv-spec/dkg"
+	"github.com/ssvlabs/ssv-spec/types"
+)
+
+var TestingWithdrawalCredentials = []byte{0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x53, 0x59, 0x53, 0xb5, 0xa6, 0x4, 0x0, 0x74, 0x94, 0x8c, 0xf1, 0x85, 0xea, 0xa7, 0xd2, 0xab, 0xbd, 0x66, 0x80, 0x8f}
+
+// TestingDKGRequestID is requested by the key set's first DKG operator
+var TestingDKGRequestID = func(keySet *TestKeySet) dkg.RequestID {
+	return dkg.NewRequestID(keySet.DKGOperators[1].ETHAddress, 1)
+}
+
+// TestingDKGInit returns a DKG request for all the key set's operators with the key set's threshold
+var TestingDKGInit = func(keySet *TestKeySet) *dkg.Init {
+	ret := &dkg.Init{
+		Operators:             make([]*dkg.Operator, 0, len(keySet.DKGOperators)),
+		Threshold:             keySet.Threshold,
+		WithdrawalCredentials: TestingWithdrawalCredentials,
+		Fork:                  types.BeaconTestNetwork.ForkVersion(),
+		Owner:                 keySet.DKGOperators[1].ETHAddress,
+	}
+	for id, operator := range keySet.DKGOperators {
+		encryptionPK, err := x509.MarshalPKIXPublicKey(&operator.EncryptionKey.PublicKey)
+		if err != nil {
+			panic(err.Error())
+		}
+		ssvOperatorPK, err := x509.MarshalPKIXPublicKey(&keySet.OperatorKeys[id].PublicKey)
+		if err != nil {
+			panic(err.Error())
+		}
+		ret.Operators = append(ret.Operators, &dkg.Operator{
+			OperatorID:        id,
+			ETHAddress:        operator.ETHAddress,
+			EncryptionPubKey:  encryptionPK,
+			SSVOperatorPubKey: ssvOperatorPK,
+		})
+	}
+	sort.Slice(ret.Operators, func(i, j int) bool {
+		return ret.Operators[i].OperatorID < ret.Operators[j].OperatorID
+	})
+	return ret
+}
+
+// TestingDKGConfig returns the operator's DKG config broadcasting to the network
+var TestingDKGConfig = func(keySet *TestKeySet, operatorID types.OperatorID, network dkg.Network) *dkg.Config {
+	return &dkg.Config{
+		Signer:            NewTestingKeyManager(),
+		OperatorSigner:    NewTestingOperatorSigner(keySet, operatorID),
+		SignatureVerifier: NewTestingVerifier(),
+		Network:           network,
+		Domain:            TestingSSVDomainType,
+		EncryptionKey:     keySet.DKGOperators[operatorID].EncryptionKey,
+	}
+}
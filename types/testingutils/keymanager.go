@@ -71,6 +71,10 @@ func NewTestingKeyManagerWithSlashableRoots(slashableDataRoots [][]byte) *testin
 		for _, s := range testingShareSet.Shares {
 			_ = ret.AddShare(s)
 		}
+		// refreshed shares sign after a share rotation
+		for _, s := range testingShareSet.Refreshed().Shares {
+			_ = ret.AddShare(s)
+		}
 		for _, o := range testingShareSet.DKGOperators {
 			ret.ecdsaKeys[o.ETHAddress.String()] = o.SK
 		}
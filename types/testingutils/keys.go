@@ -2,11 +2,15 @@ package testingutils
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/hex"
+	"fmt"
 
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/herumi/bls-eth-go-binary/bls"
@@ -47,17 +51,18 @@ type TestKeySet struct {
 	}
 }
 
+// KeySetForShare returns the testing key set of the share's committee size (its quorum is by voting power, see TestingWeightedShare)
 func KeySetForShare(share *types.Share) *TestKeySet {
-	if share.Quorum == 5 {
+	switch len(share.Committee) {
+	case 7:
 		return Testing7SharesSet()
-	}
-	if share.Quorum == 7 {
+	case 10:
 		return Testing10SharesSet()
-	}
-	if share.Quorum == 9 {
+	case 13:
 		return Testing13SharesSet()
+	default:
+		return Testing4SharesSet()
 	}
-	return Testing4SharesSet()
 }
 
 func Testing4SharesSet() *TestKeySet {
@@ -450,3 +455,69 @@ func (ks *TestKeySet) Committee() []*types.Operator {
 	}
 	return committee
 }
+
+// TestingEd25519OperatorKey returns the operator's deterministic Ed25519 SSV operator key
+func TestingEd25519OperatorKey(operatorID types.OperatorID) ed25519.PrivateKey {
+	seed := sha256.Sum256([]byte(fmt.Sprintf("ed25519 operator key %d", operatorID)))
+	return ed25519.NewKeyFromSeed(seed[:])
+}
+
+// TestingSecp256k1OperatorKey returns the operator's deterministic secp256k1 SSV operator key
+func TestingSecp256k1OperatorKey(operatorID types.OperatorID) *secp256k1.PrivateKey {
+	seed := sha256.Sum256([]byte(fmt.Sprintf("secp256k1 operator key %d", operatorID)))
+	return secp256k1.PrivKeyFromBytes(seed[:])
+}
+
+// TestingOperatorWithKeyType returns a copy of the committee operator with its SSV operator key of the key type
+func TestingOperatorWithKeyType(operator *types.Operator, keyType types.OperatorKeyType) *types.Operator {
+	ret := *operator
+	ret.SSVOperatorKeyType = keyType
+	switch keyType {
+	case types.Ed25519OperatorKey:
+		ret.SSVOperatorPubKey = TestingEd25519OperatorKey(operator.OperatorID).Public().(ed25519.PublicKey)
+	case types.Secp256k1OperatorKey:
+		ret.SSVOperatorPubKey = TestingSecp256k1OperatorKey(operator.OperatorID).PubKey().SerializeCompressed()
+	}
+	return &ret
+}
+
+// TestingRotatingOperator returns a copy of the committee operator rotating its (RSA) SSV operator key to its key of the next key
+// type, activated at the activation epoch
+func TestingRotatingOperator(operator *types.Operator, nextKeyType types.OperatorKeyType, activationEpoch spec.Epoch) *types.Operator {
+	next := TestingOperatorWithKeyType(operator, nextKeyType)
+	ret := *operator
+	ret.NextSSVOperatorPubKey = next.SSVOperatorPubKey
+	ret.NextSSVOperatorKeyType = next.SSVOperatorKeyType
+	ret.NextSSVOperatorKeyEpoch = types.OperatorKeyEpoch(activationEpoch)
+	return &ret
+}
+
+// Refreshed returns a copy of the key set with the shares refreshed by a fixed zero secret polynomial (see dkg.Refresh),
+// the validator key is unchanged
+func (ks *TestKeySet) Refreshed() *TestKeySet {
+	types.InitBLS()
+	coefficients := make([]bls.SecretKey, ks.Threshold)
+	for i := 1; i < len(coefficients); i++ {
+		seed := sha256.Sum256([]byte(fmt.Sprintf("refresh coefficient %d", i)))
+		if err := coefficients[i].SetLittleEndianMod(seed[:]); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	ret := *ks
+	ret.Shares = make(map[types.OperatorID]*bls.SecretKey)
+	for id, share := range ks.Shares {
+		blsID := bls.ID{}
+		if err := blsID.SetDecString(fmt.Sprintf("%d", id)); err != nil {
+			panic(err.Error())
+		}
+		shift := bls.SecretKey{}
+		if err := shift.Set(coefficients, &blsID); err != nil {
+			panic(err.Error())
+		}
+		refreshed := *share
+		refreshed.Add(&shift)
+		ret.Shares[id] = &refreshed
+	}
+	return &ret
+}
@@ -1,33 +1,48 @@
 package testingutils
 
 import (
-	"crypto"
-	"crypto/rand"
-	"crypto/rsa"
-	"crypto/sha256"
-
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ssvlabs/ssv-spec/types"
 )
 
 type testingOperatorSigner struct {
-	SSVOperatorSK *rsa.PrivateKey
+	types.OperatorSigner
 }
 
+// NewTestingOperatorSigner returns a signer of the operator's RSA SSV operator key
 func NewTestingOperatorSigner(keySet *TestKeySet, operatorID types.OperatorID) *testingOperatorSigner {
 	return &testingOperatorSigner{
-		SSVOperatorSK: keySet.OperatorKeys[operatorID],
+		OperatorSigner: types.NewRSAOperatorSigner(keySet.OperatorKeys[operatorID]),
 	}
 }
 
-func (km *testingOperatorSigner) SignSSVMessage(data []byte) ([256]byte, error) {
-	hash := sha256.Sum256(data)
-	signature, err := rsa.SignPKCS1v15(rand.Reader, km.SSVOperatorSK, crypto.SHA256, hash[:])
-	if err != nil {
-		return [256]byte{}, err
+// NewTestingOperatorSignerWithKeyType returns a signer of the operator's SSV operator key of the key type (see TestingOperatorWithKeyType)
+func NewTestingOperatorSignerWithKeyType(keySet *TestKeySet, operatorID types.OperatorID, keyType types.OperatorKeyType) *testingOperatorSigner {
+	switch keyType {
+	case types.Ed25519OperatorKey:
+		return &testingOperatorSigner{OperatorSigner: types.NewEd25519OperatorSigner(TestingEd25519OperatorKey(operatorID))}
+	case types.Secp256k1OperatorKey:
+		return &testingOperatorSigner{OperatorSigner: types.NewSecp256k1OperatorSigner(TestingSecp256k1OperatorKey(operatorID))}
+	default:
+		return NewTestingOperatorSigner(keySet, operatorID)
 	}
+}
 
-	sig := [256]byte{}
-	copy(sig[:], signature)
-
-	return sig, nil
+// NewTestingRotatingOperatorSigner returns a signer at the epoch of the operator rotating its (RSA) SSV operator key to its key of
+// the next key type at the activation epoch (see TestingRotatingOperator)
+func NewTestingRotatingOperatorSigner(
+	keySet *TestKeySet,
+	operatorID types.OperatorID,
+	nextKeyType types.OperatorKeyType,
+	activationEpoch phase0.Epoch,
+	epoch phase0.Epoch,
+) *testingOperatorSigner {
+	return &testingOperatorSigner{
+		OperatorSigner: types.NewRotatingOperatorSigner(
+			NewTestingOperatorSigner(keySet, operatorID),
+			NewTestingOperatorSignerWithKeyType(keySet, operatorID, nextKeyType),
+			activationEpoch,
+			func() phase0.Epoch { return epoch },
+		),
+	}
 }
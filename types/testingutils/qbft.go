@@ -35,8 +35,9 @@ var TestingConfig = func(keySet *TestKeySet) *qbft.Config {
 		ProposerF: func(state *qbft.State, round qbft.Round) types.OperatorID {
 			return 1
 		},
-		Network: NewTestingNetwork(1, keySet.OperatorKeys[1]),
-		Timer:   NewTestingTimer(),
+		Network:        NewTestingNetwork(1, keySet.OperatorKeys[1]),
+		Timer:          NewTestingTimer(),
+		SignatureCache: types.NewBLSSignatureCache(0),
 	}
 }
 
@@ -55,6 +56,27 @@ var TestingShare = func(keysSet *TestKeySet) *types.Share {
 	}
 }
 
+// TestingWeightedShare returns a share whose committee operators have the given voting power (1 if not set) and whose
+// quorums are computed by voting power. For a valid share no operator may hold more than f voting power and the key set threshold
+// must not exceed the share's MinQuorumSize
+var TestingWeightedShare = func(keysSet *TestKeySet, weights map[types.OperatorID]uint64) *types.Share {
+	ret := TestingShare(keysSet)
+	for _, operator := range ret.Committee {
+		operator.Weight = weights[operator.OperatorID]
+	}
+	ret.Quorum, ret.PartialQuorum = types.ComputeQuorum(ret.TotalWeight())
+	return ret
+}
+
+// TestingMixedKeysShare returns a share whose committee operators have SSV operator keys of the given key types (RSA if not set)
+var TestingMixedKeysShare = func(keysSet *TestKeySet, keyTypes map[types.OperatorID]types.OperatorKeyType) *types.Share {
+	ret := TestingShare(keysSet)
+	for i, operator := range ret.Committee {
+		ret.Committee[i] = TestingOperatorWithKeyType(operator, keyTypes[operator.OperatorID])
+	}
+	return ret
+}
+
 var BaseInstance = func() *qbft.Instance {
 	return baseInstance(TestingShare(Testing4SharesSet()), Testing4SharesSet(), []byte{1, 2, 3, 4})
 }
@@ -71,6 +93,11 @@ var ThirteenOperatorsInstance = func() *qbft.Instance {
 	return baseInstance(TestingShare(Testing13SharesSet()), Testing13SharesSet(), []byte{1, 2, 3, 4})
 }
 
+// WeightedInstance returns a 7 operators instance where operator 1 has voting power 2, quorum is 6 (of 8)
+var WeightedInstance = func() *qbft.Instance {
+	return baseInstance(TestingWeightedShare(Testing7SharesSet(), map[types.OperatorID]uint64{1: 2}), Testing7SharesSet(), []byte{1, 2, 3, 4})
+}
+
 var baseInstance = func(share *types.Share, keySet *TestKeySet, identifier []byte) *qbft.Instance {
 	ret := qbft.NewInstance(TestingConfig(keySet), share, identifier, qbft.FirstHeight)
 	ret.StartValue = TestingQBFTFullData
@@ -11,7 +11,7 @@ import (
 	"github.com/ssvlabs/ssv-spec/types"
 )
 
-var TestingSignedSSVMessageSignature = [256]byte{1, 2, 3, 4}
+var TestingSignedSSVMessageSignature = append([]byte{1, 2, 3, 4}, make([]byte, 252)...)
 
 var TestingMessageID = types.NewMsgID(TestingSSVDomainType, TestingValidatorPubKey[:], types.BNRoleAttester)
 
@@ -40,13 +40,11 @@ var TestingSignedSSVMessage = func(sk *bls.SecretKey, operatorID types.OperatorI
 	if err != nil {
 		panic(err.Error())
 	}
-	sig := [256]byte{}
-	copy(sig[:], signature)
 
 	//SignedSSVMessage
 	return &types.SignedSSVMessage{
 		OperatorID: operatorID,
-		Signature:  sig,
+		Signature:  signature,
 		Data:       ssvMsgByts,
 	}
 }
@@ -91,12 +89,9 @@ var SignedSSVMessageOnData = func(operatorID types.OperatorID, rsaSK *rsa.Privat
 		panic(err)
 	}
 
-	sig := [256]byte{}
-	copy(sig[:], signature)
-
 	return &types.SignedSSVMessage{
 		OperatorID: operatorID,
-		Signature:  sig,
+		Signature:  signature,
 		Data:       data,
 	}
 }
@@ -114,12 +109,19 @@ var SignedSSVMessageWithSigner = func(operatorID types.OperatorID, rsaSK *rsa.Pr
 	if err != nil {
 		panic(err)
 	}
-	sig := [256]byte{}
-	copy(sig[:], signature)
 
 	return &types.SignedSSVMessage{
 		OperatorID: operatorID,
-		Signature:  sig,
+		Signature:  signature,
 		Data:       data,
 	}
 }
+
+// SignedSSVMessageWithKeyType returns the SSVMessage signed by the operator's SSV operator key of the key type (see TestingOperatorWithKeyType)
+var SignedSSVMessageWithKeyType = func(ks *TestKeySet, operatorID types.OperatorID, keyType types.OperatorKeyType, ssvMessage *types.SSVMessage) *types.SignedSSVMessage {
+	ret, err := types.SSVMessageToSignedSSVMessage(ssvMessage, operatorID, NewTestingOperatorSignerWithKeyType(ks, operatorID, keyType).SignSSVMessage)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
@@ -6,7 +6,7 @@ import (
 )
 
 var BaseValidator = func(keySet *TestKeySet) *ssv.Validator {
-	return ssv.NewValidator(
+	ret, err := ssv.NewValidator(
 		NewTestingNetwork(1, keySet.OperatorKeys[1]),
 		NewTestingBeaconNode(),
 		TestingShare(keySet),
@@ -21,4 +21,8 @@ var BaseValidator = func(keySet *TestKeySet) *ssv.Validator {
 		},
 		NewTestingVerifier(),
 	)
+	if err != nil {
+		panic(err.Error())
+	}
+	return ret
 }
@@ -2,22 +2,30 @@ package testingutils
 
 import (
 	"bytes"
-	"crypto"
-	"crypto/rsa"
 	"crypto/sha256"
-	"crypto/x509"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/ssvlabs/ssv-spec/types"
 )
 
 type testingVerifier struct {
-	signaturesCache map[types.OperatorID]map[[32]byte][256]byte
+	signaturesCache map[types.OperatorID]map[[32]byte][]byte
+	// epoch is the epoch messages are verified at (accepting the operators' keys valid at it), nil for the current keys only
+	epoch *phase0.Epoch
 }
 
 func NewTestingVerifier() types.SignatureVerifier {
 	return &testingVerifier{
-		signaturesCache: make(map[uint64]map[[32]byte][256]byte),
+		signaturesCache: make(map[uint64]map[[32]byte][]byte),
+	}
+}
+
+// NewTestingVerifierAtEpoch returns a testing verifier accepting the operators' SSV operator keys valid at the epoch
+func NewTestingVerifierAtEpoch(epoch phase0.Epoch) types.SignatureVerifier {
+	return &testingVerifier{
+		signaturesCache: make(map[uint64]map[[32]byte][]byte),
+		epoch:           &epoch,
 	}
 }
 
@@ -36,19 +44,14 @@ func (v *testingVerifier) Verify(msg *types.SignedSSVMessage, operators []*types
 				return nil
 			}
 
-			// Get public key
-			parsedPk, err := x509.ParsePKIXPublicKey(op.SSVOperatorPubKey)
-			if err != nil {
-				return errors.Wrap(err, "could not parse signer public key")
-			}
-			pk, ok := parsedPk.(*rsa.PublicKey)
-			if !ok {
-				return errors.New("could not parse signer public key")
+			// Verify with the operator's key type
+			var err error
+			if v.epoch != nil {
+				err = types.VerifyOperatorSignatureAtEpoch(op, *v.epoch, msg.Data, msg.Signature)
+			} else {
+				err = types.VerifyOperatorSignature(op, msg.Data, msg.Signature)
 			}
 
-			// Verify
-			err = rsa.VerifyPKCS1v15(pk, crypto.SHA256, hash[:], msg.Signature[:])
-
 			if err == nil {
 				v.SaveSignature(op.OperatorID, hash, msg.Signature)
 			}
@@ -59,7 +62,7 @@ func (v *testingVerifier) Verify(msg *types.SignedSSVMessage, operators []*types
 	return errors.New("unknown signer")
 }
 
-func (v *testingVerifier) HasSignature(operatorID types.OperatorID, root [32]byte, signature [256]byte) bool {
+func (v *testingVerifier) HasSignature(operatorID types.OperatorID, root [32]byte, signature []byte) bool {
 	if _, found := v.signaturesCache[operatorID]; !found {
 		return false
 	}
@@ -69,12 +72,12 @@ func (v *testingVerifier) HasSignature(operatorID types.OperatorID, root [32]byt
 		return false
 	}
 
-	return bytes.Equal(storedSignature[:], signature[:])
+	return bytes.Equal(storedSignature, signature)
 }
 
-func (v *testingVerifier) SaveSignature(operatorID types.OperatorID, root [32]byte, signature [256]byte) {
+func (v *testingVerifier) SaveSignature(operatorID types.OperatorID, root [32]byte, signature []byte) {
 	if _, found := v.signaturesCache[operatorID]; !found {
-		v.signaturesCache[operatorID] = make(map[[32]byte][256]byte)
+		v.signaturesCache[operatorID] = make(map[[32]byte][]byte)
 	}
 	v.signaturesCache[operatorID][root] = signature
 }